@@ -0,0 +1,123 @@
+package adapter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/tokenizer"
+)
+
+const (
+	// TextField is the field of the incoming messages holding the text to stem.
+	TextField = "text"
+	// TokensField is the field added to the outgoing messages, holding the tokens of the text.
+	TokensField = "tokens"
+)
+
+var (
+	// ErrInvalidMessage is returned for a message that isn't a JSON object.
+	ErrInvalidMessage = errors.New("adapter: message is not a JSON object")
+	// ErrNoText is returned for a message without a string text field.
+	ErrNoText = errors.New("adapter: message has no text field")
+)
+
+// Token is a word of the text of a message, with its stem and, if the stemmer extracts roots, its root.
+type Token struct {
+	Word string `json:"word"`
+	Stem string `json:"stem"`
+	Root string `json:"root,omitempty"`
+}
+
+// rooter is implemented by stemmers extracting roots, such as ArabicLightStemmer.
+type rooter interface {
+	Root(word string) string
+}
+
+// wordTokenizer is implemented by stemmers with their own tokenization, such as ArabicLightStemmer.
+type wordTokenizer interface {
+	Tokenize(text string) []string
+}
+
+// Handler returns a message handler for queue consumers such as Kafka or NSQ: it parses a JSON object with
+// a text field, adds a tokens field holding the words of the text with their stems and roots, and serializes
+// the object back. The other fields of the message are kept. Roots are only given by stemmers having a Root
+// method, and the text is split by the stemmer's Tokenize method if it has one, or else by the default tokenizer.
+// The handler is safe for concurrent use if the stemmer is.
+func Handler(s stemmer.Stemmer) func(msg []byte) ([]byte, error) {
+	p := newProcessor(s)
+	return func(msg []byte) ([]byte, error) {
+		return p.process(msg, make(map[string]Token))
+	}
+}
+
+// BatchHandler returns a handler processing a batch of messages like Handler, stemming each distinct word
+// of the batch once. A message that can't be processed yields a nil output, and its error, wrapped with
+// its index in the batch, is joined to the returned error; the other messages are still processed.
+func BatchHandler(s stemmer.Stemmer) func(msgs [][]byte) ([][]byte, error) {
+	p := newProcessor(s)
+	return func(msgs [][]byte) ([][]byte, error) {
+		cache := make(map[string]Token)
+		outputs := make([][]byte, len(msgs))
+		var errs []error
+		for i, msg := range msgs {
+			output, err := p.process(msg, cache)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("message %d: %w", i, err))
+				continue
+			}
+			outputs[i] = output
+		}
+		return outputs, errors.Join(errs...)
+	}
+}
+
+// processor stems the text of messages with a stemmer.
+type processor struct {
+	stemmer  stemmer.Stemmer
+	rooter   rooter
+	tokenize func(text string) []string
+}
+
+// newProcessor creates a processor, using the root extraction and tokenization of the stemmer if it has them.
+func newProcessor(s stemmer.Stemmer) *processor {
+	p := &processor{stemmer: s, tokenize: tokenizer.NewTokenizer().Tokenize}
+	if r, ok := s.(rooter); ok {
+		p.rooter = r
+	}
+	if t, ok := s.(wordTokenizer); ok {
+		p.tokenize = t.Tokenize
+	}
+	return p
+}
+
+// process adds the tokens field to a message, looking the words up in the cache before stemming them.
+func (p *processor) process(msg []byte, cache map[string]Token) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil || fields == nil {
+		return nil, ErrInvalidMessage
+	}
+	var text string
+	if raw, exists := fields[TextField]; !exists || json.Unmarshal(raw, &text) != nil {
+		return nil, ErrNoText
+	}
+
+	tokens := []Token{}
+	for _, word := range p.tokenize(text) {
+		token, exists := cache[word]
+		if !exists {
+			token = Token{Word: word, Stem: p.stemmer.LightStem(word)}
+			if p.rooter != nil {
+				token.Root = p.rooter.Root(word)
+			}
+			cache[word] = token
+		}
+		tokens = append(tokens, token)
+	}
+	encoded, err := json.Marshal(tokens)
+	if err != nil {
+		return nil, err
+	}
+	fields[TokensField] = encoded
+	return json.Marshal(fields)
+}
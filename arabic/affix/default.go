@@ -0,0 +1,11 @@
+package affix
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+)
+
+// DefaultRules returns the rules equivalent to the built-in NOUN_AFFIX_LIST and VERB_AFFIX_LIST, without conditions.
+// The stem checks of the stemmer itself, such as verb stamps, apply on top of them.
+func DefaultRules() []Rule {
+	return append(FromAffixList(TagNoun, constant.NOUN_AFFIX_LIST), FromAffixList(TagVerb, constant.VERB_AFFIX_LIST)...)
+}
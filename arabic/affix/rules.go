@@ -0,0 +1,127 @@
+package affix
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tags of the affix rules, naming the part of speech a prefix-suffix pair applies to.
+const (
+	TagVerb = "verb"
+	TagNoun = "noun"
+)
+
+// Rule allows a prefix-suffix pair for a part of speech, optionally under conditions on the stem.
+// Zero-valued conditions are not checked.
+type Rule struct {
+	Prefix string `json:"prefix" yaml:"prefix"`
+	Suffix string `json:"suffix" yaml:"suffix"`
+	// Tag is TagVerb or TagNoun.
+	Tag string `json:"tag" yaml:"tag"`
+	// MinStemLength and MaxStemLength bound the number of letters of the stem.
+	MinStemLength int `json:"min_stem_length,omitempty" yaml:"min_stem_length,omitempty"`
+	MaxStemLength int `json:"max_stem_length,omitempty" yaml:"max_stem_length,omitempty"`
+	// FirstLetters lists the letters the stem may start with.
+	FirstLetters string `json:"first_letters,omitempty" yaml:"first_letters,omitempty"`
+	// ExcludedLetters lists the letters the stem must not contain.
+	ExcludedLetters string `json:"excluded_letters,omitempty" yaml:"excluded_letters,omitempty"`
+}
+
+// Matches reports whether the stem satisfies the conditions of the rule.
+func (r Rule) Matches(stem string) bool {
+	length := utf8.RuneCountInString(stem)
+	if r.MinStemLength > 0 && length < r.MinStemLength {
+		return false
+	}
+	if r.MaxStemLength > 0 && length > r.MaxStemLength {
+		return false
+	}
+	if r.FirstLetters != "" {
+		first, _ := utf8.DecodeRuneInString(stem)
+		if !strings.ContainsRune(r.FirstLetters, first) {
+			return false
+		}
+	}
+	return r.ExcludedLetters == "" || !strings.ContainsAny(stem, r.ExcludedLetters)
+}
+
+type RuleSet interface {
+	Allows(tag, prefix, suffix, stem string) bool
+	HasPair(tag, prefix, suffix string) bool
+	Rules() []Rule
+}
+
+// ruleKey identifies the rules of a prefix-suffix pair for a part of speech.
+type ruleKey struct {
+	tag, prefix, suffix string
+}
+
+// ruleSet indexes rules by tag and prefix-suffix pair.
+type ruleSet struct {
+	rules []Rule
+	index map[ruleKey][]Rule
+}
+
+// NewRuleSet creates a new instance of RuleSet with the provided rules.
+// A pair may carry several rules, in which case it is allowed when any of them matches the stem.
+func NewRuleSet(rules []Rule) RuleSet {
+	rs := &ruleSet{rules: append([]Rule{}, rules...), index: make(map[ruleKey][]Rule, len(rules))}
+	for _, rule := range rules {
+		key := ruleKey{rule.Tag, rule.Prefix, rule.Suffix}
+		rs.index[key] = append(rs.index[key], rule)
+	}
+	return rs
+}
+
+// Allows reports whether the prefix-suffix pair is allowed for the part of speech with the given stem.
+func (rs *ruleSet) Allows(tag, prefix, suffix, stem string) bool {
+	for _, rule := range rs.index[ruleKey{tag, prefix, suffix}] {
+		if rule.Matches(stem) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPair reports whether any rule allows the prefix-suffix pair for the part of speech, whatever the stem.
+func (rs *ruleSet) HasPair(tag, prefix, suffix string) bool {
+	return len(rs.index[ruleKey{tag, prefix, suffix}]) > 0
+}
+
+// Rules returns the rules of the set, in the order they were given.
+func (rs *ruleSet) Rules() []Rule {
+	return append([]Rule{}, rs.rules...)
+}
+
+// FromAffixList converts a flat affix list, whose entries are written "prefix-suffix",
+// into unconditional rules for the given part of speech. Duplicate entries are kept once.
+func FromAffixList(tag string, affixes []string) []Rule {
+	var rules []Rule
+	seen := make(map[string]bool, len(affixes))
+	for _, affix := range affixes {
+		if seen[affix] {
+			continue
+		}
+		seen[affix] = true
+		prefix, suffix, _ := strings.Cut(affix, "-")
+		rules = append(rules, Rule{Prefix: prefix, Suffix: suffix, Tag: tag})
+	}
+	return rules
+}
+
+// LoadJSON reads rules written as a JSON array of Rule objects, and checks that each has a known tag.
+func LoadJSON(r io.Reader) ([]Rule, error) {
+	var rules []Rule
+	if err := json.NewDecoder(r).Decode(&rules); err != nil {
+		return nil, err
+	}
+	for i, rule := range rules {
+		if rule.Tag != TagVerb && rule.Tag != TagNoun {
+			return nil, fmt.Errorf("affix: rule %d (%s-%s): unknown tag %q", i, rule.Prefix, rule.Suffix, rule.Tag)
+		}
+	}
+	return rules, nil
+}
@@ -0,0 +1,142 @@
+package affix
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// minWeight replaces weights that are missing or not positive, so that scores stay finite.
+const minWeight = 1e-6
+
+// Weights holds the relative frequencies of prefixes, suffixes and stem lengths,
+// used to rank the segmentations of a word by likelihood.
+type Weights struct {
+	Prefixes map[string]float64 `json:"prefixes" yaml:"prefixes"`
+	Suffixes map[string]float64 `json:"suffixes" yaml:"suffixes"`
+	// StemLengths is keyed by the number of letters of the stem.
+	StemLengths map[int]float64 `json:"stem_lengths" yaml:"stem_lengths"`
+	// Unknown is the weight of a prefix, suffix or stem length missing from the maps.
+	Unknown float64 `json:"unknown" yaml:"unknown"`
+}
+
+// Example is a word of a tagged corpus with the stem annotated for it.
+type Example struct {
+	Word string
+	Stem string
+}
+
+// DefaultWeights returns weights favoring stems of three to five letters, without preference among affixes.
+// They curb over-stemming of short words when no weights have been learned.
+func DefaultWeights() Weights {
+	return Weights{
+		StemLengths: map[int]float64{1: 0.01, 2: 0.05, 3: 0.35, 4: 0.3, 5: 0.15, 6: 0.08, 7: 0.04},
+		Unknown:     0.01,
+	}
+}
+
+// Score returns the log-likelihood of the segmentation of a word into the prefix, a stem of the given length,
+// and the suffix. Higher scores are more likely.
+func (w Weights) Score(prefix, suffix string, stemLength int) float64 {
+	score := math.Log(w.weight(w.Prefixes[prefix]))
+	score += math.Log(w.weight(w.Suffixes[suffix]))
+	return score + math.Log(w.weight(w.StemLengths[stemLength]))
+}
+
+// weight returns the given weight, or the unknown weight when it's missing.
+func (w Weights) weight(value float64) float64 {
+	if value <= 0 {
+		value = w.Unknown
+	}
+	return math.Max(value, minWeight)
+}
+
+// Clone returns a deep copy of the weights.
+func (w Weights) Clone() Weights {
+	return Weights{
+		Prefixes:    cloneMap(w.Prefixes),
+		Suffixes:    cloneMap(w.Suffixes),
+		StemLengths: cloneMap(w.StemLengths),
+		Unknown:     w.Unknown,
+	}
+}
+
+// Learn estimates weights from the relative frequencies of the affixes and stem lengths in a tagged corpus.
+// Examples whose stem doesn't occur in the word are skipped. Frequencies are add-one smoothed,
+// and the unknown weight is that of an unseen value.
+func Learn(examples []Example) Weights {
+	prefixes := make(map[string]int)
+	suffixes := make(map[string]int)
+	stemLengths := make(map[int]int)
+	total := 0
+	for _, example := range examples {
+		i := strings.Index(example.Word, example.Stem)
+		if example.Stem == "" || i < 0 {
+			continue
+		}
+		prefixes[example.Word[:i]]++
+		suffixes[example.Word[i+len(example.Stem):]]++
+		stemLengths[utf8.RuneCountInString(example.Stem)]++
+		total++
+	}
+	if total == 0 {
+		return DefaultWeights()
+	}
+
+	vocabulary := max(len(prefixes), len(suffixes), len(stemLengths))
+	denominator := float64(total + vocabulary + 1)
+	return Weights{
+		Prefixes:    frequencies(prefixes, denominator),
+		Suffixes:    frequencies(suffixes, denominator),
+		StemLengths: frequencies(stemLengths, denominator),
+		Unknown:     1 / denominator,
+	}
+}
+
+// LoadWeightsJSON reads weights written as a JSON Weights object, and checks that none is negative.
+func LoadWeightsJSON(r io.Reader) (Weights, error) {
+	var w Weights
+	if err := json.NewDecoder(r).Decode(&w); err != nil {
+		return Weights{}, err
+	}
+	if w.Unknown < 0 {
+		return Weights{}, fmt.Errorf("affix: negative unknown weight %g", w.Unknown)
+	}
+	for name, values := range map[string]map[string]float64{"prefix": w.Prefixes, "suffix": w.Suffixes} {
+		for affix, value := range values {
+			if value < 0 {
+				return Weights{}, fmt.Errorf("affix: negative weight %g for %s %q", value, name, affix)
+			}
+		}
+	}
+	for length, value := range w.StemLengths {
+		if value < 0 {
+			return Weights{}, fmt.Errorf("affix: negative weight %g for stem length %d", value, length)
+		}
+	}
+	return w, nil
+}
+
+// frequencies converts add-one smoothed counts into relative frequencies.
+func frequencies[K comparable](counts map[K]int, denominator float64) map[K]float64 {
+	result := make(map[K]float64, len(counts))
+	for key, count := range counts {
+		result[key] = float64(count+1) / denominator
+	}
+	return result
+}
+
+// cloneMap returns a copy of the map, or nil for a nil map.
+func cloneMap[K comparable](m map[K]float64) map[K]float64 {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]float64, len(m))
+	for key, value := range m {
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+)
+
+// RootCoverage lists the surface forms of a document deriving from one root.
+type RootCoverage struct {
+	Root string `json:"root"`
+	// Count is the number of tokens deriving from the root.
+	Count int `json:"count"`
+	// Forms holds the unvocalized surface forms of the root with their counts, most frequent first.
+	Forms []Frequency `json:"forms"`
+}
+
+// CoverRoots builds a root-level concordance of the document: for every root found, the surface forms
+// deriving from it and their counts. Stopwords and tokens without a root are left out.
+// Roots are listed by decreasing count, then in lexicographic order.
+func CoverRoots(als *stemmer.ArabicLightStemmer, document string) []RootCoverage {
+	formCounts := make(map[string]map[string]int)
+	rootCounts := make(map[string]int)
+	roots := make(map[string]string)
+
+	for _, token := range als.Tokenize(document) {
+		if als.IsStopword(token) {
+			continue
+		}
+		form := normalize.StripTashkeel(token)
+		root, exists := roots[form]
+		if !exists {
+			root = als.Root(form)
+			roots[form] = root
+		}
+		if root == "" {
+			continue
+		}
+		if formCounts[root] == nil {
+			formCounts[root] = make(map[string]int)
+		}
+		formCounts[root][form]++
+		rootCounts[root]++
+	}
+
+	coverage := make([]RootCoverage, 0, len(rootCounts))
+	for _, f := range sortFrequencies(rootCounts, 0) {
+		coverage = append(coverage, RootCoverage{Root: f.Term, Count: f.Count, Forms: sortFrequencies(formCounts[f.Term], 0)})
+	}
+	return coverage
+}
@@ -0,0 +1,119 @@
+package analysis
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// Frequency is the number of occurrences of a stem or root in a corpus.
+type Frequency struct {
+	Term  string `json:"term"`
+	Count int    `json:"count"`
+}
+
+// FrequencyOptions configures the frequency analysis of a corpus.
+type FrequencyOptions struct {
+	// TopN limits each table to its N most frequent terms. Zero or less keeps every term.
+	TopN int
+	// ExcludeStopwords leaves stopwords out of the tables.
+	ExcludeStopwords bool
+}
+
+// FrequencyReport holds the stem and root frequency tables of a corpus, most frequent terms first.
+type FrequencyReport struct {
+	Tokens int         `json:"tokens"`
+	Stems  []Frequency `json:"stems"`
+	Roots  []Frequency `json:"roots"`
+}
+
+// CountFrequencies reads a corpus, tokenizes it line by line and counts the stems and roots found by the given stemmer.
+// Tokens counts every token read, including stopwords and tokens without a stem. Each distinct word is stemmed only once.
+func CountFrequencies(als *stemmer.ArabicLightStemmer, r io.Reader, options FrequencyOptions) (FrequencyReport, error) {
+	type analysis struct {
+		stem, root string
+	}
+	cache := make(map[string]analysis)
+	stemCounts := make(map[string]int)
+	rootCounts := make(map[string]int)
+	report := FrequencyReport{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		for _, token := range als.Tokenize(scanner.Text()) {
+			report.Tokens++
+			if options.ExcludeStopwords && als.IsStopword(token) {
+				continue
+			}
+			a, exists := cache[token]
+			if !exists {
+				a = analysis{stem: als.LightStem(token), root: als.Root(token)}
+				cache[token] = a
+			}
+			if a.stem != "" {
+				stemCounts[a.stem]++
+			}
+			if a.root != "" {
+				rootCounts[a.root]++
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return FrequencyReport{}, err
+	}
+
+	report.Stems = sortFrequencies(stemCounts, options.TopN)
+	report.Roots = sortFrequencies(rootCounts, options.TopN)
+	return report, nil
+}
+
+// WriteCSV writes the report as CSV rows of kind ("stem" or "root"), term and count, preceded by a header row.
+func (fr FrequencyReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"kind", "term", "count"}); err != nil {
+		return err
+	}
+	for _, table := range []struct {
+		kind        string
+		frequencies []Frequency
+	}{{"stem", fr.Stems}, {"root", fr.Roots}} {
+		for _, f := range table.frequencies {
+			if err := writer.Write([]string{table.kind, f.Term, strconv.Itoa(f.Count)}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes the report as an indented JSON document.
+func (fr FrequencyReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(fr)
+}
+
+// sortFrequencies converts the counts into a table sorted by decreasing count, then by term,
+// and keeps the first topN entries if topN is positive.
+func sortFrequencies(counts map[string]int, topN int) []Frequency {
+	frequencies := make([]Frequency, 0, len(counts))
+	for term, count := range counts {
+		frequencies = append(frequencies, Frequency{Term: term, Count: count})
+	}
+	sort.Slice(frequencies, func(i, j int) bool {
+		if frequencies[i].Count != frequencies[j].Count {
+			return frequencies[i].Count > frequencies[j].Count
+		}
+		return frequencies[i].Term < frequencies[j].Term
+	})
+	if topN > 0 && len(frequencies) > topN {
+		frequencies = frequencies[:topN]
+	}
+	return frequencies
+}
@@ -0,0 +1,201 @@
+package analysis
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WordFrequency is the number of occurrences of a word in a corpus and the number of documents holding it.
+type WordFrequency struct {
+	Word      string `json:"word"`
+	Count     int    `json:"count"`
+	Documents int    `json:"documents"`
+	// StemDocuments is the number of documents holding any form of the stem of the word, when counted
+	// by CountWordFrequencies, and 0 when unknown.
+	StemDocuments int `json:"stem_documents,omitempty"`
+}
+
+// StopwordOptions configures the generation of stopword candidates.
+type StopwordOptions struct {
+	// TopN keeps the N stems found in the most documents. Zero or less keeps every stem.
+	TopN int
+	// ExcludeKnown leaves out the stems of which a form is already a stopword of the stemmer,
+	// so that only new stopwords are proposed, e.g. for a dialect.
+	ExcludeKnown bool
+}
+
+// StopwordCandidate is a stem proposed as a stopword, with the surface forms it was found under.
+type StopwordCandidate struct {
+	Stem string `json:"stem"`
+	// Documents is the number of documents holding any form of the stem, and Count the number of occurrences
+	// of the stem. Without the stem document frequencies of CountWordFrequencies, Documents is the largest
+	// document frequency of the forms, a lower bound.
+	Documents int `json:"documents"`
+	Count     int `json:"count"`
+	// Forms are the surface forms of the stem, most frequent first.
+	Forms []WordFrequency `json:"forms"`
+}
+
+// ReadWordFrequencies reads a word frequency list: one word per line, followed by its number of occurrences
+// and, optionally, its document frequency, separated by tabs, commas or spaces. The document frequency defaults
+// to the number of occurrences. Empty lines and lines starting with # are skipped.
+func ReadWordFrequencies(r io.Reader) ([]WordFrequency, error) {
+	var frequencies []WordFrequency
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.FieldsFunc(text, func(char rune) bool { return char == '\t' || char == ',' || char == ' ' })
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("analysis: line %d: expected a word, a count and an optional document frequency", line)
+		}
+		frequency := WordFrequency{Word: fields[0]}
+		var err error
+		if frequency.Count, err = strconv.Atoi(fields[1]); err != nil {
+			return nil, fmt.Errorf("analysis: line %d: %w", line, err)
+		}
+		frequency.Documents = frequency.Count
+		if len(fields) == 3 {
+			if frequency.Documents, err = strconv.Atoi(fields[2]); err != nil {
+				return nil, fmt.Errorf("analysis: line %d: %w", line, err)
+			}
+		}
+		frequencies = append(frequencies, frequency)
+	}
+	return frequencies, scanner.Err()
+}
+
+// CountWordFrequencies reads a corpus holding one document per line and counts the occurrences and document
+// frequency of its unvocalized words, tokenized by the stemmer, along with the document frequency of their stems,
+// each document counted once per stem. Words are sorted by decreasing document frequency.
+func CountWordFrequencies(als *stemmer.ArabicLightStemmer, r io.Reader) ([]WordFrequency, error) {
+	counts := make(map[string]*WordFrequency)
+	stems := make(map[string]string)
+	stemDocuments := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		seen := make(map[string]bool)
+		seenStems := make(map[string]bool)
+		for _, token := range als.Tokenize(scanner.Text()) {
+			word := normalize.StripTashkeel(token)
+			frequency, exists := counts[word]
+			if !exists {
+				frequency = &WordFrequency{Word: word}
+				counts[word] = frequency
+				stems[word] = als.LightStem(word)
+			}
+			frequency.Count++
+			if !seen[word] {
+				seen[word] = true
+				frequency.Documents++
+			}
+			if stem := stems[word]; !seenStems[stem] {
+				seenStems[stem] = true
+				stemDocuments[stem]++
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	frequencies := make([]WordFrequency, 0, len(counts))
+	for word, frequency := range counts {
+		frequency.StemDocuments = stemDocuments[stems[word]]
+		frequencies = append(frequencies, *frequency)
+	}
+	sortWordFrequencies(frequencies)
+	return frequencies, nil
+}
+
+// StopwordCandidates stems the words of a frequency profile and proposes the stems found in the most documents
+// as stopwords, sorted by decreasing document frequency, then by stem.
+func StopwordCandidates(als *stemmer.ArabicLightStemmer, words []WordFrequency, options StopwordOptions) []StopwordCandidate {
+	byStem := make(map[string]*StopwordCandidate)
+	known := make(map[string]bool)
+	for _, word := range words {
+		stem := als.LightStem(word.Word)
+		if stem == "" {
+			continue
+		}
+		candidate, exists := byStem[stem]
+		if !exists {
+			candidate = &StopwordCandidate{Stem: stem}
+			byStem[stem] = candidate
+		}
+		// The forms of a stem counted by CountWordFrequencies share its document frequency
+		candidate.Documents = max(candidate.Documents, word.StemDocuments, word.Documents)
+		candidate.Count += word.Count
+		candidate.Forms = append(candidate.Forms, word)
+		if options.ExcludeKnown && als.IsStopword(word.Word) {
+			known[stem] = true
+		}
+	}
+
+	candidates := make([]StopwordCandidate, 0, len(byStem))
+	for stem, candidate := range byStem {
+		if known[stem] {
+			continue
+		}
+		sortWordFrequencies(candidate.Forms)
+		candidates = append(candidates, *candidate)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Documents != candidates[j].Documents {
+			return candidates[i].Documents > candidates[j].Documents
+		}
+		return candidates[i].Stem < candidates[j].Stem
+	})
+	if options.TopN > 0 && len(candidates) > options.TopN {
+		candidates = candidates[:options.TopN]
+	}
+	return candidates
+}
+
+// WriteStopwords writes the candidates in the JSON format of stopwords.json, with an entry for every form,
+// so that the output can be loaded with the Reload method of the stopword manager. The stem of the candidate
+// is both the stem and the lemma of its forms, which have no clitics or tags.
+func WriteStopwords(w io.Writer, candidates []StopwordCandidate) error {
+	entries := make(map[string]map[string]string)
+	for _, candidate := range candidates {
+		for _, form := range candidate.Forms {
+			entries[form.Word] = map[string]string{
+				"word":      form.Word,
+				"vocalized": form.Word,
+				"stem":      candidate.Stem,
+				"original":  candidate.Stem,
+				"procletic": "",
+				"encletic":  "",
+				"tags":      "",
+				"type":      "STOPWORD",
+			}
+		}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// sortWordFrequencies sorts the words by decreasing document frequency, then count, then in lexicographic order.
+func sortWordFrequencies(frequencies []WordFrequency) {
+	sort.Slice(frequencies, func(i, j int) bool {
+		a, b := frequencies[i], frequencies[j]
+		if a.Documents != b.Documents {
+			return a.Documents > b.Documents
+		}
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Word < b.Word
+	})
+}
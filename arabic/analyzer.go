@@ -0,0 +1,126 @@
+package arabic
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+)
+
+// Analyzer is the entry point of the library, combining normalization, tokenization, stopword detection,
+// segmentation, stemming and root extraction behind a single type. It is safe for concurrent use.
+type Analyzer interface {
+	// Stem returns the light stem of the word.
+	Stem(word string) string
+	// Root returns the root of the word.
+	Root(word string) string
+	// Segment returns the prefix, stem and suffix the word was split into.
+	Segment(word string) stemmer.Segmentation
+	// Normalize brings the text into the canonical form used for search.
+	Normalize(text string) string
+	// IsStopword reports whether the word is a stopword.
+	IsStopword(word string) bool
+	// Tokenize splits the text into words.
+	Tokenize(text string) []string
+	// Analyze tokenizes the text and analyzes each of its words.
+	Analyze(text string) []Analysis
+	// Readings returns every consistent morphological reading of the word.
+	Readings(word string) []stemmer.Analysis
+	// Concordance returns the words of the text whose stem or root is the key, with their context.
+	Concordance(text, key string, window int) []stemmer.KWICLine
+	// Similarity returns the similarity of two documents between 0 and 1, from the stems they share.
+	Similarity(docA, docB string) float64
+	// NormalizeTitle returns a canonical key for a title, shared by titles differing only in word order and inflection.
+	NormalizeTitle(s string) string
+	// Stemmer returns the underlying stemmer, for the options and analyses the facade doesn't cover.
+	// Changing its options while the analyzer is in use is not safe.
+	Stemmer() *stemmer.ArabicLightStemmer
+}
+
+// Analysis holds the results of every subsystem for a word of a text.
+type Analysis struct {
+	Word         string
+	Normalized   string
+	Stem         string
+	Root         string
+	Segmentation stemmer.Segmentation
+	Stopword     bool
+}
+
+// analyzer implements Analyzer on top of a stemmer, which owns every other subsystem.
+type analyzer struct {
+	stemmer *stemmer.ArabicLightStemmer
+}
+
+// NewAnalyzer creates an Analyzer with the default configuration.
+func NewAnalyzer() Analyzer {
+	return &analyzer{stemmer: stemmer.NewArabicLightStemmer()}
+}
+
+// NewAnalyzerFromConfig creates an Analyzer with the given configuration, e.g. stemmer.DefaultConfig
+// with some options changed. It returns the validation errors of the configuration.
+func NewAnalyzerFromConfig(cfg stemmer.Config) (Analyzer, error) {
+	als, err := stemmer.NewFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &analyzer{stemmer: als}, nil
+}
+
+func (a *analyzer) Stem(word string) string {
+	return a.stemmer.LightStem(word)
+}
+
+func (a *analyzer) Root(word string) string {
+	return a.stemmer.Root(word)
+}
+
+func (a *analyzer) Segment(word string) stemmer.Segmentation {
+	return a.stemmer.StemSegmentation(word)
+}
+
+func (a *analyzer) Normalize(text string) string {
+	return a.stemmer.NormalizeSearchText(text)
+}
+
+func (a *analyzer) IsStopword(word string) bool {
+	return a.stemmer.IsStopword(word)
+}
+
+func (a *analyzer) Tokenize(text string) []string {
+	return a.stemmer.Tokenize(text)
+}
+
+func (a *analyzer) Analyze(text string) []Analysis {
+	words := a.stemmer.Tokenize(text)
+	analyses := make([]Analysis, len(words))
+	stopwords := a.stemmer.StopwordMask(words)
+	for i, word := range words {
+		analyses[i] = Analysis{
+			Word:         word,
+			Normalized:   a.stemmer.NormalizeSearchText(word),
+			Stem:         a.stemmer.LightStem(word),
+			Root:         a.stemmer.Root(word),
+			Segmentation: a.stemmer.StemSegmentation(word),
+			Stopword:     stopwords[i],
+		}
+	}
+	return analyses
+}
+
+func (a *analyzer) Readings(word string) []stemmer.Analysis {
+	return a.stemmer.Analyze(word)
+}
+
+func (a *analyzer) Concordance(text, key string, window int) []stemmer.KWICLine {
+	return a.stemmer.Concordance(text, key, window)
+}
+
+func (a *analyzer) Similarity(docA, docB string) float64 {
+	return a.stemmer.Similarity(docA, docB)
+}
+
+func (a *analyzer) NormalizeTitle(s string) string {
+	return a.stemmer.NormalizeTitle(s)
+}
+
+func (a *analyzer) Stemmer() *stemmer.ArabicLightStemmer {
+	return a.stemmer
+}
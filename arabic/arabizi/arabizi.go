@@ -0,0 +1,149 @@
+package arabizi
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type ArabiziConverter interface {
+	IsArabizi(word string) bool
+	Convert(word string) string
+}
+
+// arabiziConverter converts Arabizi (romanized Arabic, e.g. "7abibi", "3ala") to Arabic script.
+type arabiziConverter struct {
+	mapping map[string]string
+	keys    []string
+}
+
+// NewArabiziConverter creates a new instance of ArabiziConverter with the provided mapping of Latin letters,
+// digraphs and digits to Arabic letters. Keys are matched case-insensitively, longest first.
+func NewArabiziConverter(mapping map[string]string) ArabiziConverter {
+	ac := &arabiziConverter{mapping: make(map[string]string, len(mapping))}
+	for key, value := range mapping {
+		key = strings.ToLower(key)
+		ac.mapping[key] = value
+		ac.keys = append(ac.keys, key)
+	}
+	sort.Slice(ac.keys, func(i, j int) bool {
+		if len(ac.keys[i]) != len(ac.keys[j]) {
+			return len(ac.keys[i]) > len(ac.keys[j])
+		}
+		return ac.keys[i] < ac.keys[j]
+	})
+	return ac
+}
+
+// IsArabizi checks if the given word is written in Arabizi: Latin letters, optionally mixed with the digits
+// and apostrophes used for Arabic sounds, with at least one Latin letter. As most such words are English or
+// other Latin-script words, the word must also hold a lone digit of the mapping, e.g. 7abibi, or one of
+// the digraphs of constant.ARABIZI_DIGRAPHS and constant.ARABIZI_INITIAL_DIGRAPHS, e.g. khalas.
+func (ac *arabiziConverter) IsArabizi(word string) bool {
+	hasLetter := false
+	for _, char := range word {
+		switch {
+		case char < unicode.MaxASCII && unicode.IsLetter(char):
+			hasLetter = true
+		case char < unicode.MaxASCII && (unicode.IsDigit(char) || char == '\''):
+		default:
+			return false
+		}
+	}
+	if !hasLetter {
+		return false
+	}
+	return ac.hasArabiziDigit(word) || hasArabiziDigraph(strings.ToLower(word))
+}
+
+// hasArabiziDigit reports whether the ASCII word holds a digit of the mapping standing alone among letters,
+// as digits written for Arabic sounds do, unlike the numbers of words such as COVID19.
+func (ac *arabiziConverter) hasArabiziDigit(word string) bool {
+	isDigit := func(i int) bool { return i >= 0 && i < len(word) && word[i] >= '0' && word[i] <= '9' }
+	for i := range word {
+		if _, mapped := ac.mapping[word[i:i+1]]; mapped && isDigit(i) && !isDigit(i-1) && !isDigit(i+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasArabiziDigraph reports whether the lowercase word holds a digraph telling Arabizi apart from English.
+func hasArabiziDigraph(word string) bool {
+	for _, digraph := range constant.ARABIZI_DIGRAPHS {
+		if strings.Contains(word, digraph) {
+			return true
+		}
+	}
+	for _, digraph := range constant.ARABIZI_INITIAL_DIGRAPHS {
+		if strings.HasPrefix(word, digraph) {
+			return true
+		}
+	}
+	return false
+}
+
+// Convert transliterates an Arabizi word into Arabic script. Consonants and digits are converted through
+// the mapping. Vowels follow the usual spelling conventions: an initial vowel is written as ALEF, a doubled
+// vowel as a long vowel, a final a as ALEF and a final e as TEH MARBUTA, medial i, o and u as YEH and WAW,
+// while medial a and e are short vowels and are dropped.
+func (ac *arabiziConverter) Convert(word string) string {
+	word = strings.ToLower(word)
+	var result strings.Builder
+	for i := 0; i < len(word); {
+		if isVowel(word[i]) {
+			run := i
+			for run < len(word) && isVowel(word[run]) {
+				run++
+			}
+			result.WriteString(convertVowels(word[i:run], i == 0, run == len(word)))
+			i = run
+			continue
+		}
+
+		matched := false
+		for _, key := range ac.keys {
+			if strings.HasPrefix(word[i:], key) {
+				result.WriteString(ac.mapping[key])
+				i += len(key)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+	return result.String()
+}
+
+// convertVowels converts a run of Latin vowels according to its position in the word.
+func convertVowels(vowels string, initial, final bool) string {
+	first := vowels[0]
+	long := len(vowels) > 1
+	switch {
+	case initial:
+		if first == 'o' || first == 'u' {
+			return constant.ALEF_HAMZA_ABOVE + constant.WAW
+		}
+		if first == 'i' && long {
+			return constant.ALEF_HAMZA_BELOW + constant.YEH
+		}
+		return constant.ALEF
+	case final && first == 'e' && !long:
+		return constant.TEH_MARBUTA
+	case first == 'i' || first == 'e' && long || strings.HasPrefix(vowels, "ei") || strings.HasPrefix(vowels, "ai"):
+		return constant.YEH
+	case first == 'o' || first == 'u':
+		return constant.WAW
+	case first == 'a' && (long || final):
+		return constant.ALEF
+	}
+	return ""
+}
+
+// isVowel checks if the given byte is a Latin vowel.
+func isVowel(char byte) bool {
+	return strings.IndexByte("aeiou", char) >= 0
+}
@@ -0,0 +1,30 @@
+package arabizi
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"testing"
+)
+
+func TestIsArabizi(t *testing.T) {
+	tests := []struct {
+		word    string
+		arabizi bool
+	}{
+		{"7abibi", true},
+		{"mar7aba", true},
+		{"ma3'rib", true},
+		{"khalas", true},
+		{"ghali", true},
+		{"hello", false},
+		{"night", false},
+		{"COVID19", false},
+		{"2020", false},
+		{"حبيبي", false},
+	}
+	converter := NewArabiziConverter(constant.DEFAULT_ARABIZI_MAPPING)
+	for _, tt := range tests {
+		if got := converter.IsArabizi(tt.word); got != tt.arabizi {
+			t.Errorf("IsArabizi(%q) = %v, want %v", tt.word, got, tt.arabizi)
+		}
+	}
+}
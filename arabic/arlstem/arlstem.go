@@ -0,0 +1,328 @@
+package arlstem
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+	"unicode/utf8"
+)
+
+// Affix lists of the algorithm, named after the steps using them.
+var (
+	// prefixes2 are removed from words of five letters or more: ال، لل، فل، فب.
+	prefixes2 = []string{constant.ALEF + constant.LAM, constant.LAM + constant.LAM, constant.FEH + constant.LAM, constant.FEH + constant.BEH}
+	// prefixes3 are removed from words of six letters or more: بال، كال، وال.
+	prefixes3 = []string{constant.BEH + constant.ALEF + constant.LAM, constant.KAF + constant.ALEF + constant.LAM, constant.WAW + constant.ALEF + constant.LAM}
+	// prefixes32 are removed from words of six letters or more, after prefixes4: فلل، ولل.
+	prefixes32 = []string{constant.FEH + constant.LAM + constant.LAM, constant.WAW + constant.LAM + constant.LAM}
+	// prefixes4 are removed from words of seven letters or more: فبال، وبال، فكال.
+	prefixes4 = []string{
+		constant.FEH + constant.BEH + constant.ALEF + constant.LAM,
+		constant.WAW + constant.BEH + constant.ALEF + constant.LAM,
+		constant.FEH + constant.KAF + constant.ALEF + constant.LAM,
+	}
+
+	// suffixes2 and suffixes22 are the second and third person pronouns: كي، كم and ها، هم.
+	suffixes2  = []string{constant.KAF + constant.YEH, constant.KAF + constant.MEEM}
+	suffixes22 = []string{constant.HEH + constant.ALEF, constant.HEH + constant.MEEM}
+	// suffixes3 and suffixes32 are the dual and feminine plural pronouns: كما، كنّ and هما، هنّ.
+	// The forms with a shadda never match, since diacritics are removed first, as in the reference implementation.
+	suffixes3  = []string{constant.KAF + constant.MEEM + constant.ALEF, constant.KAF + constant.NOON + constant.SHADDA}
+	suffixes32 = []string{constant.HEH + constant.MEEM + constant.ALEF, constant.HEH + constant.NOON + constant.SHADDA}
+
+	// pluralSuffixes2 and pluralSuffixes3 are the dual and sound plural endings: ان، ين، ون and تان، تين.
+	pluralSuffixes2 = []string{constant.ALEF + constant.NOON, constant.YEH + constant.NOON, constant.WAW + constant.NOON}
+	pluralSuffixes3 = []string{constant.TEH + constant.ALEF + constant.NOON, constant.TEH + constant.YEH + constant.NOON}
+
+	// verbSuffixes2 are the imperfect dual and plural endings: ان، ون.
+	verbSuffixes2 = []string{constant.ALEF + constant.NOON, constant.WAW + constant.NOON}
+	// verbPrefixes2 and verbPrefixes22 are the future prefixes: ست، سي and سا، سن.
+	verbPrefixes2  = []string{constant.SEEN + constant.TEH, constant.SEEN + constant.YEH}
+	verbPrefixes22 = []string{constant.SEEN + constant.ALEF, constant.SEEN + constant.NOON}
+	// verbSuffixes3, verbSuffixes2b and verbSuffixes1 are the perfect endings: تما، تنّ and نا، تم، تا، وا and ت، ا، ن.
+	verbSuffixes3  = []string{constant.TEH + constant.MEEM + constant.ALEF, constant.TEH + constant.NOON + constant.SHADDA}
+	verbSuffixes2b = []string{constant.NOON + constant.ALEF, constant.TEH + constant.MEEM, constant.TEH + constant.ALEF, constant.WAW + constant.ALEF}
+	verbSuffixes1  = []string{constant.TEH, constant.ALEF, constant.NOON}
+)
+
+// Stem applies the ARLSTem light stemmer of Abainia, Ouamour and Sayoud (2017), following the reference
+// implementation of NLTK. After normalization, a prefix and a pronoun suffix are removed, then the word is
+// reduced to the singular, or else to the masculine; a word left without prefix is stemmed as a verb instead.
+func Stem(word string) string {
+	word = Normalize(word)
+	stripped, hasPrefix := prefix(word)
+	if hasPrefix {
+		word = stripped
+	}
+	word = suffix(word)
+	if singular, ok := pluralToSingular(word); ok {
+		return singular
+	}
+	if masculine, ok := feminineToMasculine(word); ok {
+		return masculine
+	}
+	if !hasPrefix {
+		return verb(word)
+	}
+	return word
+}
+
+// Normalize removes the diacritics, writes the ALEF forms carrying a hamza or a madda as a bare ALEF and
+// ALEF MAKSURA as YEH, and removes an initial WAW from words of four letters or more.
+func Normalize(word string) string {
+	word = strings.Map(func(char rune) rune {
+		switch {
+		case char >= 0x064B && char <= 0x065F:
+			return -1
+		case string(char) == constant.ALEF_MADDA, string(char) == constant.ALEF_HAMZA_ABOVE, string(char) == constant.ALEF_HAMZA_BELOW:
+			return []rune(constant.ALEF)[0]
+		case string(char) == constant.ALEF_MAKSURA:
+			return []rune(constant.YEH)[0]
+		}
+		return char
+	}, word)
+	if strings.HasPrefix(word, constant.WAW) && length(word) > 3 {
+		word = strings.TrimPrefix(word, constant.WAW)
+	}
+	return word
+}
+
+// prefix removes the definite article, possibly preceded by a preposition or conjunction, or the LAM prefixes.
+func prefix(word string) (string, bool) {
+	n := length(word)
+	if n > 5 {
+		if p, ok := startsWithAny(word, prefixes3); ok {
+			return strings.TrimPrefix(word, p), true
+		}
+	}
+	if n > 6 {
+		if p, ok := startsWithAny(word, prefixes4); ok {
+			return strings.TrimPrefix(word, p), true
+		}
+	}
+	if n > 5 {
+		if p, ok := startsWithAny(word, prefixes32); ok {
+			return strings.TrimPrefix(word, p), true
+		}
+	}
+	if n > 4 {
+		if p, ok := startsWithAny(word, prefixes2); ok {
+			return strings.TrimPrefix(word, p), true
+		}
+	}
+	return word, false
+}
+
+// suffix removes a pronoun suffix.
+func suffix(word string) string {
+	n := length(word)
+	if strings.HasSuffix(word, constant.KAF) && n > 3 {
+		return strings.TrimSuffix(word, constant.KAF)
+	}
+	if n > 4 {
+		if s, ok := endsWithAny(word, suffixes2); ok {
+			return strings.TrimSuffix(word, s)
+		}
+	}
+	if n > 5 {
+		if s, ok := endsWithAny(word, suffixes3); ok {
+			return strings.TrimSuffix(word, s)
+		}
+	}
+	if strings.HasSuffix(word, constant.HEH) && n > 3 {
+		return strings.TrimSuffix(word, constant.HEH)
+	}
+	if n > 4 {
+		if s, ok := endsWithAny(word, suffixes22); ok {
+			return strings.TrimSuffix(word, s)
+		}
+	}
+	if n > 5 {
+		if s, ok := endsWithAny(word, suffixes32); ok {
+			return strings.TrimSuffix(word, s)
+		}
+	}
+	if strings.HasSuffix(word, constant.NOON+constant.ALEF) && n > 4 {
+		return strings.TrimSuffix(word, constant.NOON+constant.ALEF)
+	}
+	return word
+}
+
+// feminineToMasculine removes the TEH MARBUTA ending words of four letters or more.
+func feminineToMasculine(word string) (string, bool) {
+	if strings.HasSuffix(word, constant.TEH_MARBUTA) && length(word) > 3 {
+		return strings.TrimSuffix(word, constant.TEH_MARBUTA), true
+	}
+	return "", false
+}
+
+// pluralToSingular removes the dual and sound plural endings, and the ALEF of the افعال broken plural form.
+func pluralToSingular(word string) (string, bool) {
+	runes := []rune(word)
+	n := len(runes)
+	if n > 4 {
+		if s, ok := endsWithAny(word, pluralSuffixes2); ok {
+			return strings.TrimSuffix(word, s), true
+		}
+	}
+	if n > 5 {
+		if s, ok := endsWithAny(word, pluralSuffixes3); ok {
+			return strings.TrimSuffix(word, s), true
+		}
+	}
+	if n > 3 && strings.HasSuffix(word, constant.ALEF+constant.TEH) {
+		return strings.TrimSuffix(word, constant.ALEF+constant.TEH), true
+	}
+	alef := []rune(constant.ALEF)[0]
+	if n > 3 && runes[0] == alef && runes[2] == alef {
+		return string(runes[:2]) + string(runes[3:]), true
+	}
+	if n > 4 && runes[0] == alef && runes[n-2] == alef {
+		return string(runes[1:n-2]) + string(runes[n-1]), true
+	}
+	return "", false
+}
+
+// verb removes the verb affixes, trying the steps in order until one applies.
+// As in the reference implementation, the step removing the future prefixes always applies,
+// so its final step, removing the LAM prefixes (لن، لت، لي، لأ), is never reached and is left out.
+func verb(word string) string {
+	for _, step := range []func(string) (string, bool){verbStep1, verbStep2, verbStep3, verbStep4} {
+		if stem, ok := step(word); ok {
+			return stem
+		}
+	}
+	return verbStep5(word)
+}
+
+// verbStep1 removes the imperfect prefix with the dual or plural ending, or the imperative ALEF with its ending.
+func verbStep1(word string) (string, bool) {
+	n := length(word)
+	if n > 5 && strings.HasPrefix(word, constant.TEH) {
+		if s, ok := endsWithAny(word, pluralSuffixes2); ok {
+			return trim(word, constant.TEH, s), true
+		}
+	}
+	if n > 5 && strings.HasPrefix(word, constant.YEH) {
+		if s, ok := endsWithAny(word, verbSuffixes2); ok {
+			return trim(word, constant.YEH, s), true
+		}
+	}
+	if n > 4 && strings.HasPrefix(word, constant.ALEF) {
+		if n > 5 && strings.HasSuffix(word, constant.WAW+constant.ALEF) {
+			return trim(word, constant.ALEF, constant.WAW+constant.ALEF), true
+		}
+		for _, s := range []string{constant.YEH, constant.ALEF, constant.NOON} {
+			if strings.HasSuffix(word, s) {
+				return trim(word, constant.ALEF, s), true
+			}
+		}
+	}
+	if n > 4 && strings.HasPrefix(word, constant.YEH) && strings.HasSuffix(word, constant.NOON) {
+		return trim(word, constant.YEH, constant.NOON), true
+	}
+	if n > 4 && strings.HasPrefix(word, constant.TEH) && strings.HasSuffix(word, constant.NOON) {
+		return trim(word, constant.TEH, constant.NOON), true
+	}
+	return "", false
+}
+
+// verbStep2 removes the future prefix with the dual or plural ending.
+func verbStep2(word string) (string, bool) {
+	n := length(word)
+	seenTeh, seenYeh := verbPrefixes2[0], verbPrefixes2[1]
+	if n > 6 {
+		if s, ok := endsWithAny(word, pluralSuffixes2); ok && strings.HasPrefix(word, seenTeh) {
+			return trim(word, seenTeh, s), true
+		}
+		if strings.HasPrefix(word, seenYeh) && strings.HasSuffix(word, pluralSuffixes2[0]) {
+			return trim(word, seenYeh, pluralSuffixes2[0]), true
+		}
+		if strings.HasPrefix(word, seenYeh) && strings.HasSuffix(word, pluralSuffixes2[2]) {
+			return trim(word, seenYeh, pluralSuffixes2[2]), true
+		}
+	}
+	if n > 5 && strings.HasPrefix(word, seenTeh) && strings.HasSuffix(word, constant.NOON) {
+		return trim(word, seenTeh, constant.NOON), true
+	}
+	if n > 5 && strings.HasPrefix(word, seenYeh) && strings.HasSuffix(word, constant.NOON) {
+		return trim(word, seenYeh, constant.NOON), true
+	}
+	return "", false
+}
+
+// verbStep3 removes the perfect endings.
+func verbStep3(word string) (string, bool) {
+	n := length(word)
+	if n > 5 {
+		if s, ok := endsWithAny(word, verbSuffixes3); ok {
+			return strings.TrimSuffix(word, s), true
+		}
+	}
+	if n > 4 {
+		if s, ok := endsWithAny(word, verbSuffixes2b); ok {
+			return strings.TrimSuffix(word, s), true
+		}
+	}
+	if n > 3 {
+		if s, ok := endsWithAny(word, verbSuffixes1); ok {
+			return strings.TrimSuffix(word, s), true
+		}
+	}
+	return "", false
+}
+
+// verbStep4 removes the imperfect prefixes.
+func verbStep4(word string) (string, bool) {
+	if length(word) > 3 {
+		if p, ok := startsWithAny(word, verbSuffixes1); ok {
+			return strings.TrimPrefix(word, p), true
+		}
+		if strings.HasPrefix(word, constant.YEH) {
+			return strings.TrimPrefix(word, constant.YEH), true
+		}
+	}
+	return "", false
+}
+
+// verbStep5 removes the future prefixes, or returns the word unchanged.
+func verbStep5(word string) string {
+	if length(word) > 4 {
+		if p, ok := startsWithAny(word, verbPrefixes22); ok {
+			return strings.TrimPrefix(word, p)
+		}
+		if p, ok := startsWithAny(word, verbPrefixes2); ok {
+			return strings.TrimPrefix(word, p)
+		}
+	}
+	return word
+}
+
+// startsWithAny returns the first of the prefixes the word starts with.
+func startsWithAny(word string, prefixes []string) (string, bool) {
+	for _, p := range prefixes {
+		if strings.HasPrefix(word, p) {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// endsWithAny returns the first of the suffixes the word ends with.
+func endsWithAny(word string, suffixes []string) (string, bool) {
+	for _, s := range suffixes {
+		if strings.HasSuffix(word, s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// trim removes the prefix and the suffix, which the word is known to start and end with.
+func trim(word, prefix, suffix string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(word, prefix), suffix)
+}
+
+// length returns the number of letters of the word.
+func length(word string) int {
+	return utf8.RuneCountInString(word)
+}
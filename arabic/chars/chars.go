@@ -0,0 +1,92 @@
+package chars
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	// Tashkeel holds the tashkeel marks: FATHATAN, DAMMATAN, KASRATAN, FATHA, DAMMA, KASRA, SHADDA and SUKUN.
+	Tashkeel = NewTable(constant.FATHATAN + constant.DAMMATAN + constant.KASRATAN + constant.FATHA +
+		constant.DAMMA + constant.KASRA + constant.SHADDA + constant.SUKUN)
+	// PrefixLetters holds the default letters prefixes are made of.
+	PrefixLetters = NewTable(constant.DEFAULT_PREFIX_LETTERS)
+	// SuffixLetters holds the default letters suffixes are made of.
+	SuffixLetters = NewTable(constant.DEFAULT_SUFFIX_LETTERS)
+	// InfixLetters holds the default letters that may be inserted inside a stem.
+	InfixLetters = NewTable(constant.DEFAULT_INFIX_LETTERS)
+	// ForeignLetters holds the letters used to transliterate sounds foreign to Arabic.
+	ForeignLetters = NewTable(constant.FOREIGN_LETTERS)
+	// Invisible holds the bidirectional controls (marks, embeddings, overrides and isolates), the zero-width
+	// space, joiner and non-joiner, the word joiner and the byte order mark, found in text copied from the web.
+	Invisible = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x061C, Hi: 0x061C, Stride: 1},
+			{Lo: 0x200B, Hi: 0x200F, Stride: 1},
+			{Lo: 0x202A, Hi: 0x202E, Stride: 1},
+			{Lo: 0x2060, Hi: 0x2060, Stride: 1},
+			{Lo: 0x2066, Hi: 0x2069, Stride: 1},
+			{Lo: 0xFEFF, Hi: 0xFEFF, Stride: 1},
+		},
+	}
+)
+
+// NewTable builds a range table holding the letters of the given string, so that membership can be tested
+// with unicode.Is instead of scanning the string. Duplicate letters are ignored and consecutive code points merged.
+func NewTable(letters string) *unicode.RangeTable {
+	runes := []rune(letters)
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	table := &unicode.RangeTable{}
+	for i := 0; i < len(runes); {
+		lo := runes[i]
+		hi := lo
+		for i < len(runes) && runes[i] <= hi+1 {
+			hi = max(hi, runes[i])
+			i++
+		}
+		if hi <= unicode.MaxLatin1 {
+			table.LatinOffset++
+		}
+		if hi <= 0xFFFF {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi), Stride: 1})
+		} else if lo > 0xFFFF {
+			table.R32 = append(table.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+		} else {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(lo), Hi: 0xFFFF, Stride: 1})
+			table.R32 = append(table.R32, unicode.Range32{Lo: 0x10000, Hi: uint32(hi), Stride: 1})
+		}
+	}
+	return table
+}
+
+// IsTashkeel reports whether the character is a tashkeel mark.
+func IsTashkeel(char rune) bool {
+	return unicode.Is(Tashkeel, char)
+}
+
+// IsInvisible reports whether the character is a bidirectional control or zero-width character.
+func IsInvisible(char rune) bool {
+	return unicode.Is(Invisible, char)
+}
+
+// ContainsAny reports whether the text contains any character of the table.
+func ContainsAny(text string, table *unicode.RangeTable) bool {
+	return strings.IndexFunc(text, func(char rune) bool { return unicode.Is(table, char) }) >= 0
+}
+
+// Mask replaces every character of the text that is not in the table with the joker.
+func Mask(text string, table *unicode.RangeTable, joker string) string {
+	var result strings.Builder
+	result.Grow(len(text))
+	for _, char := range text {
+		if unicode.Is(table, char) {
+			result.WriteRune(char)
+		} else {
+			result.WriteString(joker)
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,60 @@
+package chars
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"unicode"
+)
+
+// tatweel is the kashida, which belongs to the Common script although it only elongates Arabic letters.
+var tatweel = []rune(constant.TATWEEL)[0]
+
+// IsArabicLetter reports whether the character is a letter of the Arabic script, from the Arabic blocks,
+// their supplements and extensions, or the presentation forms.
+func IsArabicLetter(char rune) bool {
+	return unicode.IsLetter(char) && char != tatweel && unicode.Is(unicode.Arabic, char)
+}
+
+// IsArabicWord reports whether the text is a single Arabic word: Arabic letters, possibly with tashkeel or
+// other combining marks and tatweel, and nothing else. Spaces, digits and Latin letters make it false.
+func IsArabicWord(s string) bool {
+	hasLetter := false
+	for _, char := range s {
+		switch {
+		case IsArabicLetter(char):
+			hasLetter = true
+		case char == tatweel, unicode.IsMark(char):
+		default:
+			return false
+		}
+	}
+	return hasLetter
+}
+
+// ContainsArabic reports whether the text has at least one Arabic letter.
+func ContainsArabic(s string) bool {
+	for _, char := range s {
+		if IsArabicLetter(char) {
+			return true
+		}
+	}
+	return false
+}
+
+// ArabicRatio returns the share of the letters of the text that are Arabic letters, from 0 to 1.
+// Digits, punctuation, spaces and marks aren't counted, and a text without letters has a ratio of 0.
+func ArabicRatio(s string) float64 {
+	letters, arabic := 0, 0
+	for _, char := range s {
+		if !unicode.IsLetter(char) || char == tatweel {
+			continue
+		}
+		letters++
+		if unicode.Is(unicode.Arabic, char) {
+			arabic++
+		}
+	}
+	if letters == 0 {
+		return 0
+	}
+	return float64(arabic) / float64(letters)
+}
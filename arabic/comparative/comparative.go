@@ -0,0 +1,109 @@
+package comparative
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"strings"
+)
+
+// Comparative holds the result of comparative adjective (أفعل التفضيل) analysis.
+type Comparative struct {
+	// Word is the analyzed word.
+	Word string
+	// Prefix holds the proclitics and the definite article before the comparative, e.g. بال in بالأكبر.
+	Prefix string
+	// Stem is the comparative itself, keeping its leading hamza-alef, e.g. أكبر in أكبرهم.
+	Stem string
+	// Suffix is the attached pronoun, e.g. هم in أكبرهم.
+	Suffix string
+	// Root is the root of the comparative, e.g. كبر for أكبر, قلل for أقل or علو for أعلى.
+	Root string
+}
+
+type ComparativeAnalyzer interface {
+	Analyze(word string) (Comparative, bool)
+}
+
+// comparativeAnalyzer recognizes comparatives of the أفعل pattern, including those of geminate roots (أقل)
+// and of defective roots (أعلى).
+type comparativeAnalyzer struct {
+	known        map[string]bool
+	rootsManager roots.RootsManager
+}
+
+// pronounSuffixes lists the pronouns a comparative may carry as the first term of a construct, longest first.
+var pronounSuffixes = []string{"هما", "كما", "هم", "هن", "كم", "كن", "ها", "نا", "ه", "ك", "ي"}
+
+// NewComparativeAnalyzer creates a new instance of ComparativeAnalyzer with the provided known comparatives
+// and RootsManager. The RootsManager is used to check the root of the pattern.
+func NewComparativeAnalyzer(known []string, rootsManager roots.RootsManager) ComparativeAnalyzer {
+	ca := &comparativeAnalyzer{
+		known:        make(map[string]bool, len(known)),
+		rootsManager: rootsManager,
+	}
+	for _, word := range known {
+		ca.known[word] = true
+	}
+	return ca
+}
+
+// Analyze checks whether the given unvocalized word is a comparative and returns its parts and root.
+// The form أفعل is shared with the perfect of derived verbs (أخرج) and the first person of the imperfect (أكتب),
+// so a comparative is only recognized when its root is in the dictionary and either the definite article
+// or a preposition comes before it, or it is one of the known comparatives. Geminate forms, such as أقل,
+// must be known comparatives.
+func (ca *comparativeAnalyzer) Analyze(word string) (Comparative, bool) {
+	prefix := ""
+	for _, proclitic := range constant.NOUN_PROCLITICS {
+		if strings.HasPrefix(word, proclitic) {
+			prefix = proclitic
+			break
+		}
+	}
+	rest := strings.TrimPrefix(word, prefix)
+	candidates := []Comparative{{Stem: rest}}
+	if !strings.HasSuffix(prefix, constant.ALEF+constant.LAM) {
+		for _, suffix := range pronounSuffixes {
+			if stem := strings.TrimSuffix(rest, suffix); stem != rest {
+				candidates = append(candidates, Comparative{Stem: stem, Suffix: suffix})
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		// Geminate forms are short enough to be mistaken for nouns of hamzated roots, e.g. الأكل
+		if !ca.known[candidate.Stem] && (prefix == "" || len([]rune(candidate.Stem)) < 4) {
+			continue
+		}
+		if root, ok := ca.root(candidate.Stem); ok {
+			candidate.Word, candidate.Prefix, candidate.Root = word, prefix, root
+			return candidate, true
+		}
+	}
+	return Comparative{}, false
+}
+
+// root returns the root of a stem of the أفعل pattern if it is in the dictionary.
+func (ca *comparativeAnalyzer) root(stem string) (string, bool) {
+	letters := []rune(stem)
+	if len(letters) < 3 || len(letters) > 4 || string(letters[0]) != constant.ALEF_HAMZA_ABOVE {
+		return "", false
+	}
+	var candidates []string
+	switch {
+	case len(letters) == 3:
+		// أقل, أشد
+		candidates = []string{string(letters[1:]) + string(letters[2])}
+	case string(letters[3]) == constant.ALEF_MAKSURA:
+		// أعلى, أقوى
+		candidates = []string{string(letters[1:3]) + constant.WAW, string(letters[1:3]) + constant.YEH}
+	default:
+		candidates = []string{string(letters[1:])}
+	}
+	for _, candidate := range candidates {
+		if root := ca.rootsManager.NormalizeRoot(candidate); ca.rootsManager.IsRoot(root) {
+			return root, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,53 @@
+package constant
+
+// DEFAULT_ARABIZI_MAPPING maps Arabizi (romanized Arabic) letters, digraphs and digits to Arabic letters.
+// Longer keys take precedence over shorter ones during conversion. Vowels are handled separately by position.
+var DEFAULT_ARABIZI_MAPPING = map[string]string{
+	"kh": KHAH,
+	"sh": SHEEN,
+	"ch": SHEEN,
+	"th": THEH,
+	"dh": THAL,
+	"gh": GHAIN,
+	"2":  HAMZA,
+	"3'": GHAIN,
+	"3":  AIN,
+	"5":  KHAH,
+	"6'": ZAH,
+	"6":  TAH,
+	"7'": KHAH,
+	"7":  HAH,
+	"8":  QAF,
+	"9'": DAD,
+	"9":  SAD,
+	"b":  BEH,
+	"p":  BEH,
+	"t":  TEH,
+	"j":  JEEM,
+	"g":  JEEM,
+	"h":  HEH,
+	"d":  DAL,
+	"r":  REH,
+	"z":  ZAIN,
+	"s":  SEEN,
+	"f":  FEH,
+	"v":  FEH,
+	"q":  QAF,
+	"k":  KAF,
+	"c":  KAF,
+	"l":  LAM,
+	"m":  MEEM,
+	"n":  NOON,
+	"w":  WAW,
+	"y":  YEH,
+	"x":  KAF + SEEN,
+}
+
+var (
+	// ARABIZI_DIGRAPHS are Latin digraphs for Arabic sounds that English spelling hardly uses, telling Arabizi
+	// words written without digits apart from English ones, e.g. khalas.
+	ARABIZI_DIGRAPHS = []string{"kh"}
+	// ARABIZI_INITIAL_DIGRAPHS are such digraphs only telling Arabizi at the start of a word, e.g. ghali,
+	// as they also end English words such as night.
+	ARABIZI_INITIAL_DIGRAPHS = []string{"gh", "dh"}
+)
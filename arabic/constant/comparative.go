@@ -0,0 +1,10 @@
+package constant
+
+// COMPARATIVES lists common comparative adjectives (أفعل التفضيل) that are read as comparatives even without
+// the definite article, although they share their form with verbs, e.g. أكبر (bigger, or I magnify).
+var COMPARATIVES = []string{
+	"أكبر", "أصغر", "أكثر", "أقل", "أفضل", "أحسن", "أسوأ", "أعلى", "أدنى", "أقوى", "أضعف",
+	"أطول", "أقصر", "أقرب", "أبعد", "أسرع", "أبطأ", "أجمل", "أعظم", "أقدم", "أحدث", "أوسع",
+	"أضيق", "أغنى", "أفقر", "أسهل", "أصعب", "أخطر", "أهم", "أشد", "أخف", "أثقل", "أدق",
+	"أقصى", "أوثق", "أبرز", "أغلى", "أرخص", "أعمق", "أكمل", "أشهر",
+}
@@ -0,0 +1,8 @@
+package constant
+
+// DIMINUTIVES lists common diminutives of the فعيل pattern, without their feminine ending, that are read as
+// diminutives even when unvocalized, although the pattern is shared with adjectives such as كبير.
+var DIMINUTIVES = []string{
+	"كتيب", "شجير", "نهير", "جبيل", "رجيل", "بحير", "قطيط", "كليب", "وريق", "غصين",
+	"نجيم", "قمير", "جسير", "بويب", "كويس", "حجير", "ذرير",
+}
@@ -0,0 +1,27 @@
+package constant
+
+// FEMININE_NOUNS lists nouns ending in TEH MARBUTA that match the participle or adjective shapes taken for
+// feminine forms of a masculine word, while their TEH MARBUTA belongs to the noun, e.g. مدرسة (school) isn't the
+// feminine of مدرس (teacher). They are never reduced to a masculine base.
+var FEMININE_NOUNS = []string{
+	"مدرسة", "مكتبة", "مدينة", "مسألة", "مرحلة", "منطقة", "مؤسسة", "مجلة", "محكمة", "مجموعة",
+	"مشكلة", "مسافة", "مساحة", "معركة", "مملكة", "منظمة", "مطبعة", "ملعقة", "محطة", "مقالة",
+	"حقيقة", "طريقة", "دقيقة", "حكومة", "قبيلة", "وسيلة", "جريدة", "جزيرة", "حديقة", "رسالة",
+	"فاكهة", "قاعدة", "عاصمة", "قائمة", "ساعة", "خاتمة", "حالة", "غاية", "عائلة", "نافذة",
+	"جامعة", "جائزة", "عقيدة", "كنيسة", "صحيفة", "سفينة", "شريعة", "كتيبة", "فضيلة", "عاطفة",
+}
+
+// FEMININE_SINGULAR_TEMPLATES lists the templates of feminine singulars ending in TEH MARBUTA. Sound feminine
+// plurals and duals are only reduced to a feminine singular matching one of them with a root of the dictionary,
+// e.g. معلمات → معلمة but امتحانات → امتحان.
+var FEMININE_SINGULAR_TEMPLATES = []string{
+	"فعلة", "فعالة", "فعولة", "فعيلة", "فاعلة", "فعلية", "تفعلة", "تفعيلة",
+	"مفعلة", "مفعولة", "مفاعلة", "مفتعلة", "متفعلة", "متفاعلة", "منفعلة", "مستفعلة", "فعللة",
+}
+
+// MASCULINE_BASE_TEMPLATES lists the templates of the participles and adjectives whose feminine forms are reduced
+// to a masculine base, e.g. معلمة → معلم or كاتبة → كاتب. The مفاعل shape isn't one of them, as مساعدة or مفاوضات
+// are masdars of the مفاعلة template rather than feminine participles.
+var MASCULINE_BASE_TEMPLATES = []string{
+	"فاعل", "فعيل", "مفعول", "مفعل", "مفتعل", "متفعل", "متفاعل", "منفعل", "مستفعل",
+}
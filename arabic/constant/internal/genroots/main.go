@@ -0,0 +1,115 @@
+// Command genroots generates the front-coded roots table of the constant package from a list of roots,
+// one per line. It is run by go generate in the constant package.
+//
+// Each root is encoded as a byte holding the number of letters it shares with the previous root in its high
+// nibble and the number of remaining letters in its low nibble, followed by the remaining letters, one byte
+// each, as their offset from U+0600. The roots keep the order of the list.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// letterBase is the code point the letters are encoded from; every letter of a root must be in the Arabic block.
+const letterBase = 0x0600
+
+// maxLetters is the number of letters a nibble of the encoding counts.
+const maxLetters = 15
+
+// chunkSize is the number of encoded bytes per line of the generated string literal.
+const chunkSize = 48
+
+func main() {
+	output := flag.String("o", "roots_table.go", "generated Go file")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		log.Fatal("usage: genroots [-o file] roots.txt")
+	}
+
+	roots, err := readRoots(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	table, err := encode(roots)
+	if err != nil {
+		log.Fatal(err)
+	}
+	source, err := generate(flag.Arg(0), len(roots), table)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readRoots reads the non-blank lines of the list.
+func readRoots(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var roots []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if root := strings.TrimSpace(scanner.Text()); root != "" {
+			roots = append(roots, root)
+		}
+	}
+	return roots, scanner.Err()
+}
+
+// encode front codes the roots.
+func encode(roots []string) ([]byte, error) {
+	var table []byte
+	var previous []rune
+	for _, root := range roots {
+		letters := []rune(root)
+		if len(letters) > maxLetters {
+			return nil, fmt.Errorf("root %q has more than %d letters", root, maxLetters)
+		}
+		shared := 0
+		for shared < len(letters) && shared < len(previous) && letters[shared] == previous[shared] {
+			shared++
+		}
+		table = append(table, byte(shared<<4|(len(letters)-shared)))
+		for _, letter := range letters[shared:] {
+			if letter <= letterBase || letter > letterBase+0xFF {
+				return nil, fmt.Errorf("root %q has a letter outside the Arabic block: %q", root, letter)
+			}
+			table = append(table, byte(letter-letterBase))
+		}
+		previous = letters
+	}
+	return table, nil
+}
+
+// generate returns the formatted source of the table.
+func generate(input string, count int, table []byte) ([]byte, error) {
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by genroots from %s; DO NOT EDIT.\n\n", input)
+	fmt.Fprintf(&source, "package constant\n\n")
+	fmt.Fprintf(&source, "// rootsCount is the number of roots of rootsTable.\n")
+	fmt.Fprintf(&source, "const rootsCount = %d\n\n", count)
+	fmt.Fprintf(&source, "// rootsTable holds the front-coded roots, decoded by decodeRoots.\n")
+	fmt.Fprintf(&source, "const rootsTable = \"\" +\n")
+	for start := 0; start < len(table); start += chunkSize {
+		end := min(start+chunkSize, len(table))
+		fmt.Fprintf(&source, "\t%s", strconv.Quote(string(table[start:end])))
+		if end < len(table) {
+			source.WriteString(" +")
+		}
+		source.WriteString("\n")
+	}
+	return format.Source(source.Bytes())
+}
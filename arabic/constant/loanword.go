@@ -0,0 +1,82 @@
+package constant
+
+// LOANWORDS lists common transliterated foreign words and names that should not be stemmed.
+var LOANWORDS = []string{
+	"كمبيوتر",
+	"كومبيوتر",
+	"انترنت",
+	"إنترنت",
+	"تلفزيون",
+	"تليفزيون",
+	"تلفون",
+	"تليفون",
+	"موبايل",
+	"راديو",
+	"فيديو",
+	"فيسبوك",
+	"تويتر",
+	"إنستغرام",
+	"انستغرام",
+	"انستجرام",
+	"يوتيوب",
+	"واتساب",
+	"جوجل",
+	"غوغل",
+	"أمازون",
+	"مايكروسوفت",
+	"أبل",
+	"سامسونج",
+	"بنك",
+	"تكنولوجيا",
+	"ديمقراطية",
+	"دكتور",
+	"بروفيسور",
+	"استراتيجية",
+	"ميكانيكا",
+	"كيلومتر",
+	"بلاستيك",
+	"أوروبا",
+	"أمريكا",
+	"أمريكي",
+	"بريطانيا",
+	"فرنسا",
+	"ألمانيا",
+	"روسيا",
+	"كورونا",
+	"فيروس",
+	"باكستان",
+	"أفغانستان",
+	"بروتين",
+	"برلمان",
+}
+
+// LOANWORD_MORPHEMES lists letter sequences typical of transliterated foreign words,
+// such as the endings of -logy, -ism, -phone and -gram.
+var LOANWORD_MORPHEMES = []string{
+	"ولوجي",
+	"يزم",
+	"فون",
+	"ميتر",
+	"غرام",
+	"جرام",
+	"تيك",
+	"سيون",
+	"ستراتيج",
+	"كترون",
+	"بروت",
+	"ديجيتال",
+}
+
+// FOREIGN_LETTERS contains letters used to transliterate foreign sounds that have no standard Arabic letter
+// (Persian PEH, TCHEH, JEH, GAF, VEH and KEHEH).
+const FOREIGN_LETTERS = "پچژگڤک"
+
+// DEFAULT_LOANWORD_THRESHOLD is the score from which a word is considered a loanword. It is above the weight
+// of a foreign morpheme, which must be confirmed by another signal.
+const DEFAULT_LOANWORD_THRESHOLD = 0.8
+
+// DEFINITE_ARTICLES lists the definite article with the proclitics it commonly combines with, longest first.
+var DEFINITE_ARTICLES = []string{"وبال", "وكال", "فبال", "وال", "فال", "بال", "كال", "ولل", "فلل", "ال", "لل"}
+
+// SUN_LETTERS contains the letters into which the LAM of the definite article assimilates, e.g. الشَّمس.
+const SUN_LETTERS = "تثدذرزسشصضطظلن"
@@ -0,0 +1,38 @@
+package constant
+
+// NISBA_EXCEPTIONS lists words ending in YEH whose final letter belongs to the word itself rather than
+// to a nisba suffix. They are never analyzed as nisba adjectives.
+var NISBA_EXCEPTIONS = []string{
+	"كرسي",
+	"كراسي",
+	"مشي",
+	"نبي",
+	"ذكي",
+	"غني",
+	"قوي",
+	"علي",
+	"ولي",
+	"نهي",
+	"رأي",
+	"وحي",
+	"هدي",
+	"جري",
+	"سعي",
+	"رمي",
+	"بني",
+	"حي",
+	"شي",
+	"أي",
+	"الذي",
+	"التي",
+	"لدي",
+	"إلي",
+	"في",
+}
+
+// NISBA_BASE_TEMPLATES lists the templates of the nouns, mostly verbal nouns of the derived forms, from which
+// nisba adjectives are commonly formed, e.g. تعليم → تعليمي or قانون → قانوني. A final YEH after a noun of one of
+// them is read as the nisba ending rather than the possessive pronoun of the first person, unlike in كتابي.
+var NISBA_BASE_TEMPLATES = []string{
+	"تفعيل", "تفعل", "تفاعل", "افعال", "افتعال", "انفعال", "استفعال", "فاعول", "فعلان",
+}
@@ -0,0 +1,82 @@
+package constant
+
+// PATTERN_SLOTS lists the letters used as radical placeholders in morphological templates.
+// FEH, AIN and LAM stand for the first, second and third (and fourth) root letters respectively.
+var PATTERN_SLOTS = map[rune]bool{
+	[]rune(FEH)[0]: true,
+	[]rune(AIN)[0]: true,
+	[]rune(LAM)[0]: true,
+}
+
+// DEFAULT_PATTERN_LIST contains the standard Arabic morphological templates (awzan) used for pattern detection.
+// Templates are written without diacritics, so forms that differ only by vocalization or shadda share an entry.
+var DEFAULT_PATTERN_LIST = []string{
+	// Triliteral verb forms
+	"فعل",
+	"فاعل",
+	"أفعل",
+	"تفعل",
+	"تفاعل",
+	"انفعل",
+	"افتعل",
+	"افعل",
+	"استفعل",
+	"افعوعل",
+
+	// Imperfect verb forms
+	"يفعل",
+	"يفاعل",
+	"يتفعل",
+	"يتفاعل",
+	"ينفعل",
+	"يفتعل",
+	"يستفعل",
+
+	// Verbal nouns (masdar)
+	"تفعيل",
+	"تفعلة",
+	"مفاعلة",
+	"فعال",
+	"إفعال",
+	"انفعال",
+	"افتعال",
+	"استفعال",
+	"فعالة",
+	"فعولة",
+	"فعلان",
+
+	// Participles and nouns of place, time and instrument
+	"مفعول",
+	"مفعل",
+	"مفعلة",
+	"مفعال",
+	"متفعل",
+	"متفاعل",
+	"منفعل",
+	"مفتعل",
+	"مستفعل",
+
+	// Adjectives and intensive forms
+	"فعيل",
+	"فعيلة",
+	"فعول",
+	"فعلاء",
+	"فعلى",
+
+	// Broken plural templates
+	"أفعال",
+	"أفعلة",
+	"مفاعل",
+	"مفاعيل",
+	"فواعل",
+	"فعائل",
+
+	// Quadriliteral forms
+	"فعلل",
+	"تفعلل",
+	"افعنلل",
+	"افعلل",
+	"فعللة",
+	"مفعلل",
+	"متفعلل",
+}
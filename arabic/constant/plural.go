@@ -0,0 +1,60 @@
+package constant
+
+// BROKEN_PLURAL_TEMPLATES maps broken plural templates to the singular templates they are commonly formed from.
+// Singular templates are listed in order of preference.
+var BROKEN_PLURAL_TEMPLATES = map[string][]string{
+	"أفعال":  {"فعل"},
+	"أفعلة":  {"فعال", "فعيل"},
+	"فعول":   {"فعل"},
+	"فعلاء":  {"فعيل"},
+	"فعائل":  {"فعيلة", "فعالة"},
+	"فواعل":  {"فاعل", "فاعلة"},
+	"مفاعل":  {"مفعل", "مفعلة"},
+	"مفاعيل": {"مفعول", "مفعال"},
+}
+
+// BROKEN_PLURAL_EXCEPTIONS maps irregular or ambiguous broken plurals directly to their singular form.
+// Entries here take precedence over template based resolution, and the singular forms themselves are never
+// resolved further, which protects singulars that happen to fit a plural template (e.g. رسول).
+var BROKEN_PLURAL_EXCEPTIONS = map[string]string{
+	"كتب":    "كتاب",
+	"رسل":    "رسول",
+	"مدن":    "مدينة",
+	"سفن":    "سفينة",
+	"نساء":   "امرأة",
+	"رجال":   "رجل",
+	"أيام":   "يوم",
+	"أيدي":   "يد",
+	"آباء":   "أب",
+	"أمهات":  "أم",
+	"إخوة":   "أخ",
+	"إخوان":  "أخ",
+	"أخوات":  "أخت",
+	"أناس":   "إنسان",
+	"ناس":    "إنسان",
+	"قرى":    "قرية",
+	"مياه":   "ماء",
+	"أسماء":  "اسم",
+	"شفاه":   "شفة",
+	"بيوت":   "بيت",
+	"عيون":   "عين",
+	"دول":    "دولة",
+	"طلاب":   "طالب",
+	"طلبة":   "طالب",
+	"علماء":  "عالم",
+	"وزراء":  "وزير",
+	"أطباء":  "طبيب",
+	"أصدقاء": "صديق",
+	"مدارس":  "مدرسة",
+	"مساجد":  "مسجد",
+	"مكاتب":  "مكتب",
+}
+
+// BROKEN_PLURAL_SINGULARS lists singular nouns and masdars that fit a broken plural template, mostly فعول,
+// e.g. دخول or عمود. They are never resolved as plurals.
+var BROKEN_PLURAL_SINGULARS = []string{
+	"دخول", "خروج", "وصول", "حصول", "نزول", "قبول", "جلوس", "حضور", "ظهور", "شعور",
+	"مرور", "عبور", "سرور", "صعود", "هبوط", "سقوط", "ركوب", "غروب", "شروق", "طلوع",
+	"رجوع", "سكون", "وجود", "ثبوت", "وقوف", "قعود", "خضوع", "خشوع", "شمول", "نهوض",
+	"عمود", "جنوب", "فطور", "سحور", "صبور", "غفور", "شكور", "عجوز",
+}
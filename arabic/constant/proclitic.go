@@ -0,0 +1,5 @@
+package constant
+
+// NOUN_PROCLITICS lists the proclitics, possibly joined with the definite article, before which a word
+// can only be a noun, longest first.
+var NOUN_PROCLITICS = []string{"وبال", "وكال", "فبال", "فكال", "وال", "فال", "بال", "كال", "ال", "وب", "فب", "وك", "فك", "ب", "ك"}
@@ -0,0 +1,348 @@
+// Code generated by genroots from roots.txt; DO NOT EDIT.
+
+package constant
+
+// rootsCount is the number of roots of rootsTable.
+const rootsCount = 7504
+
+// rootsTable holds the front-coded roots, decoded by decodeRoots.
+const rootsTable = "" +
+	"\x03!(!!(!*!+!/!1!2!3!4!5!6!7!B!C!D!F!G!H!J\x12*(!*!1!" +
+	"D!E!F!G!H!J\x12+!!+!,!1!A!D!E!H!J\x12,!!,!/!1!2!D!E!F\x12" +
+	"--!/!F\x12.0!1!H\x12/(!/!1!D!E!H!J\x120,!0!F!J\x121(!+!,!.!1" +
+	"!2!3!4!6!7!A!B!C!E!F!H!J\x122!!(!,!-!1!2!A!B!D!E!H!" +
+	"J\x123(!/!1!3!A!D!F!H!J\x124(!-!1!4!F!J\x125*!/!1!5!D!H!J" +
+	"\x1266!E\x127/!1!7!E\x12A*!.!/!1!2!8!A!B!C!D!F!J\x12B7!J\x12C!!" +
+	"/!1!A!C!D!E!J\x12D(!*!.!/!2!3!A!B!C!D!E!G!H!J\x12E*!,!" +
+	"-!/!1!6!9!D!E!F!G!H\x12F(!*!+!-!3!6!A!B!C!F!G!J\x12G(!" +
+	"D!G!J\x12H(!.!/!1!3!A!B!D!E!F!G!J\x12J(!/!1!3!6!C!E!F!" +
+	"G\x04(!(!!,\"/D\"0F!1!3!4!7!D!F!G!H!J\x12*!!*!1!9!C!D!H\x13" +
+	"+!,\"(+!+!1!7!9!B!H\x13,(,!,!-!/!1!3!9!D!E\x13-(-!*111F" +
+	"!+111F!-\"/D!1!2!41D\"8D\"D3\x13.(.!*11\"+1!.\"/F\"09!1!2" +
+	"!3!51D\"6D!9!B!D15!F1/1B!H\x12/!!-!.!/!1!3!9!:!D!F!G" +
+	"!H!J\x120!\"(0!-!.!0!11B!911\"B117!D1.!E!H\x121!1D\"(1131" +
+	"5!*1C!+17!,1E!-!.!/19\"091F!1!21B!31E!4171B1C1E!5" +
+	"!6\"731D1E!9151E!:1+141D!B1-1417191D!C19!E1,\"F31B" +
+	"!G1E1F!H12!J\x132(2!,!.!1!2!911!:11!B!D!E1.!F!H\x123!\"" +
+	"(3\"*1!1!3!7!B!D!E1D!F\x134(4!1!4!7!9!:!B!C!E!H\x135(5!" +
+	"1!5!9!B!D!E!H\x136(6!6!9!C!E\x127!\"(7!-!.!11B!4!7!:!D!" +
+	"F!J\x12811E!8!H\x139(9!+111B!,!/\"01!115\"2B!515!616!7!9" +
+	"!B!C11!D\"F31B!H!J\x13:(:!*!+11\"//!1!21D\"3D!4!6!:!D!" +
+	"E!H!J\x13B(B!*!+!1!7!9!B!D!E!F!H!J\x12C!\"(C!*!1!3!4!9!" +
+	"C!D!E!J\x13D!215\"(D!*191J!,1E!-1E!.15!/1-1C1E!1!2!3" +
+	"1E!51B1E1J!71-1E!91C1E!:!B191B!C19!D!E!G13151B!H" +
+	"11!J\x13F(F!*!,!-!/1B!3!4!B!C!F!J\x12G!\"(G!*11!+!,\"/D!" +
+	"11,131E!2!3!4!51D!6!8!B\"CF!D13151B!E\"F3!G!H\x12H!!(" +
+	"!+!,!-!.!0!1!2!3!4!5!6!7!8!9!:!B!C!D!F!G!J\x12J(!*!" +
+	"+!-!/11!2!3!4!6\"71!8!9!:\"B1!F!G13!J\x04*!*!!1!2!B!E" +
+	"!F!J\x12((\"*(!11C!9!D!F!H\x12,1\x13-*-!A!E\x13.*.!.!0!E\x121(13" +
+	"\"*1!,1E!-!.!1!2!3!4!5!9!A\"BJ!C\"E3!G!J\x1239!H\x127H\x129(" +
+	"\"*9!1!3!5!9!D!J\x12:(\"*:!1!E!H!J\x12A!\"*A!+!-!1!A!D!F!" +
+	"G\x13B*B!9!F\x13C*C!C\x13D!(\"*D!/!5!9!A1F!D\"E0!G!H!J\x13E!11" +
+	"D\"*E!1!4!C!E!G1D\x12F!!*1D1F!.!E!F\x13G*G!E!F!H\x12H(!,!-" +
+	"\"/!!1!2!9!A!B!D!F!G!J\x12J-!.!1!2!3!9!C!E!G!J\x03+!(\"+" +
+	"!!,!/!1!7\"DD!F!J\x13(!,11!(!*\"+(!,11!1!7!B!F!J\x12*E!F" +
+	"\x13,+,!,!1!D!E!H\x13-+-!,\x12..!F\x12/:!B!E!F!H!J\x121(1,\"*J\"+" +
+	"1!/1J!1!71!1D1E!9!:!E1/171D!F!H!J\x127!!7!91E!H\x129(\"" +
+	"+9\",1!111!7!9!D1(!E\x12:(\"+:!1!E!H\x12A!\"+B!,!/!11B!D!" +
+	"F!H!J\x12B(\"+B!1!A!D\x13C+C!C!D!E\x12D(!+1D!,!.!/!7!9!:!D" +
+	"!E17\x12E!1/\"*D\"+E!,!/!1\"9/!:!D17!E!F\x12F*\"+F!7!F!J\x12G" +
+	"*\"+G!H\x12H!!(!1!9!D!F!J\x12J(\"*D!.!9\x03,!(12!+!,1!!0!1!" +
+	"2!4!5!A!D1D!H!J\x12(!!(!,1(!-!.!0!1!2!3!4!9!D!F!G!H" +
+	"!J\x12**\x13+!D!+\",+!7!D!E!H!J\x13-,(1-!-!/111D!1!3!414!8" +
+	"1E!A1D!D!E18!F!H\x13.,.!.\"/(!1!A!H\x12/(!+!-!/!1!3!4!9" +
+	"!A!D!E!F!H!J\x130!1!(\",0!0!1!9!A!D!E!H!J\x121!14!(1012" +
+	"1D1J\"+D1E1J!,1(111E!-!.!/1(1-1D1E!01E!1!2!31E!41" +
+	"(1E!6!7!91(!A1.13!D!E12!F!G!H!J\x122!!-!1!2!9!A!D!E" +
+	"11!J\x123!1F!/!1!3!9!E!H\x124!!(\",4!1!4!9!E!F!H\x1255\x1266!" +
+	"E\x1288\x129(111D1J\"+11E1F\",9!/11!1!3\"61!8!9!A1/1B1D!D" +
+	"!E11!F!H\x12A!18!*\",A!.!1!3!4!8!9!A!D!F!H!J\x12BB\x12C1\x12D" +
+	"!!(1(!*\",D!-1(1E!.1(1/1J!/!0!2!3!71!1J!81!1J!91(" +
+	"1/!:!A171819!B!D!E1B!G121B!H12!J\x12E!\",E!-!.!/!1!2" +
+	"11!3!4!9111D!D!E\"G1!J\x12F!!(10!+!-!/11!2!3!4!5!A13" +
+	"!B!F!J\x12G+\",!1G!/!1!2!4!61E!A!D!E12!F!G\"H1!J\x12H(!*" +
+	"!+!,!-!.!/!11(!2!3!4!8!9!A!B!D!E!F!G!H!J\x12J!!(!*!" +
+	"-!.!/!1!4!6!8!A!E\x04-!-!\x13(!F!(!,11\"-(!11E!3!4!6!71" +
+	"!1J!B!C11!D!F!H!J\x12*!1E!*\"-*!/!114!4!A1D!C!D!E!F!" +
+	"H!J\x12++\"-+!11(1A!D!E!H!J1D\x12,!!(!,\"-,!1!2!A!D!E!F!" +
+	"H!J\x12/!!(!+!,!/!11,!3!B1D!D!E!H!J\x130!1!0!1!A11!B!D" +
+	"1B1E!E!H!J\x121(1!15181B1J!*!+!,1D1E!-!/!1!21B1E!3!" +
+	"41E!5!6!A121415!B151A!C1+1D!E1/12!F!J\x122!1D!(\"-2!" +
+	"11B!2\"A1!B!C!D!E11!F!H!J\x123(\"-3!/!1!3!A!C1C1D!D!E" +
+	"!F!H!J\x124!1F!(\"-4!/!11,!4!7!A!C!D!E!F!H!J\x125!!(\"-5" +
+	"!/!11(1E!5!A!D!E!F!H!J\x126!!(!,11!11(1E!6!D!F!H\x127!" +
+	"!(\"-7!1!7!E11!H\x128(!11(!8!D1(!H\x12A!!*\"-A!/!1!2!3!4" +
+	"!5!6!8!A!D!F!H\x12B(\"-B!/!1!5!7!A!B!D!F!H\x12C!!/!1!4!" +
+	"C!D!E!J\x12D!!(13!*!,\"-D!2!3!7!A!B1A1E!C!D!E!H!J\x12E!" +
+	"!*!,\"-E!/1D!1!2!3!4!5!6!711\"8D!B!C!D1,1B!E!H!J11" +
+	"\x12F!!(14151D!+!,11\"/3!01J!1!3!4!711!81D1J!A!B!C1D" +
+	"!F!H!J\x12H(!*!+!,1D\"-J!/!0!1!2!3!4!51D!6!7!A121D!B" +
+	"1D!C!D!E1D!F!H!J\x12J,\"-J!/!1!2!3!4!5!6!7\"9D!A13!B!" +
+	"C!D!F!J\x03.(!1F!(!*1D!+!,\".(!/1/1J!1191B!2!3!4!5!7" +
+	"!91+1D!B!D!F!H!J\x12*!!*!11(1E!9111D!D191E!E!F!H\x12++" +
+	"!11E\"9,1E\"DE!E!J\x12,!!,\".,!D!J\x12/(!,!/!119!4!9!A11!" +
+	"D!E!F!J\x120!!0\"191A1B!91(1D!A!B!D1(1,1E!E!H!J\x121!!(" +
+	"14151B!*!+1E!,\".1!/1D!1!2!3!41(1A1E!5!71E!9!A1,1" +
+	"41B!B1D!C!E1314151B1D\"FA1B\x122(12!,!11(1,1A!2!91D!" +
+	"A!B!D1(1,!E!F!H!J\x123!!1!3!A!B!D!F!H\x124(\".4!11(1E!4" +
+	"!9!A!D!E!F!H!J\x125(!1!5!A!D!E!J\x136!D!(!,\".6!/!11(19" +
+	"1E!6!91(!A!D1(1A!E!F\x127!!(\".7!11A!7!A!D!E!H\x1288!H\x12" +
+	"99\x12A!!*!,\".A!/!1!3!4!6!9!A!B!H!J\x13B.B!B\x12D!!(1315!" +
+	",\".D!/!3!5!7!9!A!B!D!E!H!J\x12E,\".E!/!1!3!4!5!7!9!D" +
+	"!E!F\x12F!!(1315!+\",D\".F\"/A1B1D\"001J!21,11!3\"4D!71+" +
+	"\"8J!91,1B!A13!B\"C1!F!H!J\x12H(!*!+!.!/!0!1!21D!3!4!" +
+	"5!6!7!91D!A!B!D!E!F!J\x12J(!*!1!2!3!4!5!7\"9D!A!D!E\x03" +
+	"/!(!+\"/!1/!5!6!8!C!D!E!H!J\x12(!!(!,!-!.\"/(!1!3!4!:" +
+	"!B\"CD!D!G!J\x12++!1!7!9!F\x12,,\"/,!1!D!E!F!G!H\x12-(1J!,!" +
+	"-\"/1!11,!2!3!5!6!B1(1D!D171B1E!E11131D!F!H!J\x12..\"" +
+	"/.11!115!3!4!5!6!D!E1113!F\x121!!(1!1,1-1.13151C1J!" +
+	",1(1D!-1(!/1(1,11!1!2!3\"4B!5!91(141A\":4\"A31B!B19" +
+	"1D!C!E1,13151C!F!G1E!J\x1221\x123,!1!3!9!A!B!E!H\x1244!F!" +
+	"H\x125B\x1288\x129(!*!+11!,\"/9!11E!2!31,111B!5!8!9!B!C111" +
+	"31D!D1,1B!E1518!F!H\x13:(,!*\"/:!11B!4!5!A1B!D!E1114" +
+	"!F\"H4\x12A!!1!3\"73!9!A!B!F!H\x12B1!3!9!B!D!E!F!J\x12C!\"/C" +
+	"!3!9!C!D!E!F\x13D!E\"(-!+!,!-!.\"/D!3!5!81J!91A!:1A!A" +
+	"!B!C!D!E121315!G1+1E!H\x12E+!,!-1B1D!.1B\"/E!1!3!41B" +
+	"!5!9!:!B!C1D!D1,1-1B1C!E!F!G!J\x12F!!-!.\"/F!1!3!9!A" +
+	"14!B11131419\"C3!F!H\x13G(D!+\"/1191B1E1G1J!1!31E!411" +
+	"!6!A14!B141D1F!C111D1E\"DB!E1,131B!F1,!H11!J\x12H!!," +
+	"!-!.!/!1!3!4!5!9!:!A!B1D!C!D!E1D!F!G!J\x12J+!,!-!.!" +
+	"/!1!5!A!B\"C3!E!F\x030!(!*!,!-\"0!!1!7!A!D!E!H!J\x12((!-" +
+	"\"0(!1!D\x12,,!D\x12-,!-\"0-!B\"DE\"ED!H!J\x12.1\x121!!(!-\"01!1!" +
+	"2\"7!1J!91A!A1B!B17!E1D!H!J\x129(!*!,\"09!1!7!A!B\"D(1" +
+	"A\"E7!F\x12::\x13A0A!1!713!A\x12B-!7!F\x12C1!H\x12D,\"0D\"9(!:1A!A" +
+	"!B!D!J\x12E!!*\"-D\"0E!1!7!D1B!E!G!J\x12F(!F\x12G(!1!D!F!H\x12" +
+	"H(!,!-!/!1!7!9!A!B!D!F!J\x12J!!,!-!.!1!7!9!D!E!F\x031!" +
+	"(1D!/\"1!!3!A!E!J\x12(!1+!(!*!+!,!-!.!/!0\"1(!2!3!4!5" +
+	"!6!7!9!:!B!C!D!F!G!H\x12*!!(!*!,!.\"1*!9!B!C!D!E!F!H" +
+	"\x12+!!+!/!7!91F!E!F!H!J\x12,!!(!,!-1F!/\"1,!2!3!91F!A!" +
+	"D!E!F!G!H\x12-(!-\"1-!6!D!E!H!J\x12..!3!4!5!A!D!E!H\x12/!!" +
+	",!-!.!/!3!91A!:!A!E!F!G!J\x1200!D!E!H\x122!1E!(!-!.\"12" +
+	"!2!:!A!B!E!F!J\x123(!-!.\"13!3!9!:!A!D!E!F!H\x124!!-!/\"" +
+	"14!4!A!B!E!F!H\x125/\"15!5!9!A!B!F!H\x126(!-!.!/\"16!6!9" +
+	"!A!C!E!F!H\x127!!(!3!7!D!E!F!H!J\x129(1D!+!,!/1/\"19!2!" +
+	"3!4!5!6!8!9!A!B!D!E!F!H!J\x12:(!+!/\"1:!2!3!4!A!D1/!" +
+	"E!F!H\x12A!1F!*!+!-!/\"1A!2!3!4!5!6!9!:!A!B!D!G!H\x12B!" +
+	"!(!-!/\"1B!2!4!5!7!9!B!D!E!F!H!J\x12C(!-!/\"1C!2!3!6!" +
+	"9!A!C!D!E!F!H\x12E!1/12!+!,!-!.!/\"1E!2!3!4!5!6!7!91" +
+	"D!:1D1F!B!C!D!E!G12!J\x12F!!-!.!9!A!B!E!F!H\x12G(1D!,!" +
+	"/1F\"1G!2!31E!414!5!7!A!B!C!D!E13!F!H1C\"J!\x12H!!(!+" +
+	"!,!-!/1C1F!2!3!4!5!6!7!9!:!A!B!D!E!F!G!J\x12J!!(!+!" +
+	".!1!3!4!7!9!:!A!B!D!E!F!G!J\x032!(111B!*!,!/!1!21!!" +
+	"7!A!C!E!J\x13(!1!(\"*1!/!11,1B\"2(!7!911\":D!B!D!F!J\x12*" +
+	"*\x12,,!1!D!E!H\x12-(!-!1\"2-!A!C!D1A1B!E11!F\"HD\x12..!11A" +
+	"\"2.!A!E\"H1\x12/9!:!A!H\x121!1E!(1B!,!-!/1(1E!1\"21!7!9!" +
+	"A1B1F!B1A1D!C!E\"FB!J\x129(1B1D!,!1\"29!7!A11!B!D!E\"F" +
+	"A!H\x12:(11!/1(!11/\"2:!A1D!D!E\x12A*!/!1\"2A!A!F!J\x12B(!-" +
+	"\"2B!9!A1D!B!E!F!H!J\x12C!!(!*!1\"2C!C!E!F!H\x13D!E!(!,!" +
+	"-1(1A!.\"/(!21D!91(!:1(!A!B1E!D!E!G\x13E!,111C!*!,11" +
+	"!-!.11!1\"211E!9!B!C!D1B!E!F!G111D\x12F!!(\"*1!,11!-!" +
+	".11!/1B!1!7!A1D!B!E!F\"G1!J\x12G(!/!11A\"2B!A!B!C!D1," +
+	"1A1B!E1,1B1D\"F9!H171C\x12H!!(11!,!-!/!11B\"2C1J!7!9!" +
+	":!A!B1D!C!D!E!J\x13J!F!(!*!-!.!/!1!7!:!A!B!C!D!E!F!" +
+	"J\x033!(!*!/!1!31!!A!D!E!H!J\x12(!11!(!*!,!-1D!.!/!11*" +
+	"1,1/\"3(!711!9!:1D!B!C11!D!F!J\x12*1!D!F!G\x12,,!-!/!1!" +
+	"3!9!A!D!E!F\"G1!H\x12-(1D!*1F!,1D!-!1\"3-!711!A11!B\"C" +
+	"C!D!E!F!H!J\x12.!!.!/!1!7!A!D!E!F!H!J\x12/,!-!.!/!1!3!" +
+	"9!A!C!D!E!F!H!J\x121!!(1.171D!,1F!-!/1,1-1B1C1J!1!3" +
+	"11!7191D1E!91A!:!A!B1F!C!E17\"G,1/1A!H1D!J\x123J\x127!!" +
+	"-!1!9!E!F!H\x129(1(!/!1\"39!7!A!D!E!F!J\x12:(1D!1\"3:!D!" +
+	"E\x12A*1,!-!/!1\"371A1B!7!9!A!C!D!F1,!G!H!J\x12B(!*!/!1" +
+	"\"3B!7!9!A!B!D1(!E!J\x12C(1,!*!1\"3C!9!A!C!E!F!H\x12D!!(" +
+	"!*!,!-1(1/!.!31D!71!1-191F!91A1F!:1(121A!A19!B1/" +
+	"191J!C!D!E\"G(1E!H!J\x13E!/1D!*!,11!-!.!/11!11,\"311E" +
+	"!7!91/17!:1/!B!C!D1,1C!E!F!G1,1/11!H\x13F(.131C1D!*" +
+	"!,1D!-!.!/111D\"3F!71D!9!A!B!E!F!G!H!J\x12G(!,11!/!1" +
+	"!A!C!D!E!H1C\x12H!!,11!.!/1D!1!3!711!9!:!A!B!C!D!E\"" +
+	"F/!H\x12J!!(!,!-!.!1!3\"71!9!:!A!D\x034!2!3\"4!!A!E!F!H\x12" +
+	"((!+!,!-!1101B\"4(!5!9!B!C!D!E!F!G!H\x12**!1!9!:!D!E" +
+	"!F!H\x12+1!D!F\x12,(!,!0!1!9!F!H\x13-!F!(!,!-!0!1\"4-!5!7!" +
+	"A!C!E!F!H!J\x12.(!*!.!0!1!2!3\"4.!5!D!E!F\x12/-!.!/!A!B" +
+	"!F!G!H\x120(!0!1!H\x131!(!(1B!+!,19!-1A!.!/!1!2!31A\"41" +
+	"!7!91(!A!B!C!E!F1A1B!G1A!J1A\x122(!1!2!F!H\x123(!3!9!A" +
+	"\x134BD\x125(!1!5!H!J\x127!!(!-!1!3!7!9!A!E!F!J1!\x13848!8!A" +
+	"!J\x139!D!(!+!1\"49\"5(!9!A!D!F!H1017\x12:(11!11(1F!21(\"" +
+	"4:!:!A!D!H\x13A*1!1!2\"4A\"5D!9!A!B!F!G!H!J\x12B!!-!0!1\"" +
+	"4B!5!9!B!D!F!H\x12C!!/!1!2!3!9!C!D!E!G!H\x12D-!.\"4D!:!" +
+	"B!D!H\x13E!2!*!,11!.11!0!11,1.10!2!3!511!7!8!91/171" +
+	"D!B!D1D!E\"G/1D\x12F!!(1+1D\"*1!+!,!.\"/.!1\"4F!5\"81!9!" +
+	"A!B!E!F\x12G(11\",(!/!1!B!D!E!H\x12H!!(14!-!/!0!1!3!4!5" +
+	"1D!7!8!9!A!B1D!C!D!F!G!J\x12J!!(!-!.!/!1!2!5!71F!81" +
+	"E!9!A!B!D!E!F!G\x035!(\"5!!C!D!E!J\x12(!!(!-!1\"5(!9!:!F" +
+	"!H\x12*!!*!9\"B1!E!G!H\x12,,\x12-(!-!1\"5-!A!D!E!F!H\x12.(!.!/" +
+	"!1!A!E!H\x12/!!-!/!1\"5/!9!:!A!B!E!J\x131!(!(!,!-!.!/!1" +
+	"\"51!9!A!E!H!J\x137B1\x129(\"*1!/!111\"59!A111B!B!D1C!F1(" +
+	"!H\x13:(D!1!:!H\x12A*1*!-!/!1\"5A!9!:!A!B!D!F!H\x12B(!1!91" +
+	"1!B!D\x12CC!E!H\x12D(!*!,!-!.1/1E!/\"5D\"7-!9!A1-!B1-191" +
+	"E!C!D!E1-19\"G(1E!H!J\x12E!1C1D!*!-!.1/!/1-!1\"5E!91/" +
+	"!:!B11!C!D!E\"GD!J\x13F(119!,!.\"/D!9!A!B!E!F!H\x12G(!/!" +
+	"11,\"5G!D\"EE!H!J\x12H(!*!-!.!1!9!:!A!B1119!C!D!E191D" +
+	"!F!J\x12J!!(!-!/1D!1!5\"71!9!:!A!B!C!D\x036!/!2\"6!!7!D!" +
+	"F!J\x12(!!(!+!,!-!/!1!3\"6(!7!9!C!F!H!J\x12,,\"-1!1!9!E\x13" +
+	"-6-!C!D!H\x12..!2!E\x12/!!/!F!J\x121!!(!,!-!1!3!7!9\":71E\"" +
+	"A7!C!E\"G2!H!J\x1222!F\x1292\"69!7!9!A!H\x12:(!+\"6:!7!:!D!E" +
+	"!F!H\x13A!/!/19!1!2!3!7!9!A!B!F!H\x12C2\"6C!C\x12D9\"A9!D\x13E" +
+	"!C!,\"-D1F!.!/!112!2!3\"6E!:!C!E!F!J\x12F!!(!7!C!F!H!" +
+	"J\x12G!!(!*!,!/!2!3\"6(!D!J1!1D\x12H!!(!,!-!1!2!3\"6!1J!" +
+	"7!9!C19!F!J\x12J!!,!-!1!21F!3!71F!9!A!B!C!D!E\x047!7!\x12" +
+	"((!,!.!1!2\"7(!9!B!D!F!H!J\x12+!!+!1\"7+!H\x12,F\x12-+!-!11" +
+	"(1E!2!3\"7-!D1(\"E1!F!H!J\x12..!4\"7.!A!E!H\x121!!(!+1+1E" +
+	"!-\".E!/13!1!2!3191E!41-1E!71(11\":41E!A1314!B!E1-" +
+	"101314\"GE!H!J1E1F\x123!!3!9!D!E!H!J\x124!!4!H\x129,!11(!2" +
+	"!31B!9!D!E!F\x12:1!E!H!J\x12A!1F!-!0!1!3!4\"7A!A!B!D!F!" +
+	"H\x13B7B!B\x12D(!+!-1(1F!.1E1F!31E\"7D!9!:!A1!1-!B!D!E1" +
+	"3!G!H!J13\x13E!F!+!-11!113!31D\"7E!9!:!D13!E!F!H!J\x12F" +
+	"!!(1D\"+1!,!-!.!2\"7F!A1314!F!J\x12G1!3!4!A1D!B!D1(13" +
+	"!E1D!H!J1D\x12H!!-!.!/!1!3!4!7!9!A!B!D!J\x12J(!-!.!1!3" +
+	"1D!4!7!9!A\"D3!E!F\x038!(!*!1\"8!!A\x13(8(!J\x12,,\x121(!1!A!J" +
+	"\x129F\x12A1!A\x12D9!A!D!E!J\x12E!!J\x12FF\x12G1\x12HA!J\x12J!\x039(!!(!*!+" +
+	"!/1/!1!3!41E!7\"9(!B11131J!C!D!E!F\"GD!H!J\x12*(!*!/!" +
+	"1131A\"9*!A!B!C!D!E!F!G!H11!J\x12++!,11!1\"9+!B\"CD!D1" +
+	"(!E!F!H\x12,(!,!11A1E!2!3\"9,!A!D1/12!E!F!G1F!H\x12//!1" +
+	"13!3\"9/!A!B!C!D!E!F\"G1!H\x120(!1!A11!B!D1,1B!E!F!H!" +
+	"J17\x121(1/1F!*1F!,1,1F!/13!1!21E!3!4!51A!6!712131D" +
+	"\"91!A121517!B1(1D!C13!E1316!F!H14!J\x122(!,!/!1!2\"9" +
+	"2!A!B!D!E!F!H!J\x123(!,11\"-1!/!1!3\"7D1E\"93!A!B1(1A!" +
+	"C11!D1(1,!E!F!H!J\x124(\",0!/!11B!2!4!7!A!B!E!F17!H\x12" +
+	"5(!/!1!5!A11!D1(1,!E!F!H1/!J\x136!D!(11!/!1!6!D!G!H" +
+	"\x137!D!(!11/!3!4!7\"97!A!D13!F!H\x138!D!(!1!8\"98!D1E!E" +
+	"!F!H!J\x12A*!,1,!/!11*13!2!3!4!5\"6,!71D\"9A!A!B13!C!" +
+	"D17!F14!G!H\x12B(1D!/!11(!5\"9B!A1112!B!D!E!H!J\x12C(13" +
+	"14!/!11/14!2!3!41(!5!8!A!C!D!E!F!H!J\x12D(1J!+!,!/1" +
+	"J!2!317!5!6!713\"9D!A1517!B1E!C131C!D!E!F!G1,1/13" +
+	"1516!H1/171F!J\x12E*!,!/!117!3!4!7\"9E!B!D131B!E!F!G" +
+	"!J\x12F(13!*1*111D!,1/11!/1D\"0J!21B!3!417!5!7\"8D1J\"" +
+	"9F!A1415!B14!C1+1114!E!F!H1F!J\x12G(!/!1\"9G!F!H\x12H+!" +
+	",!/1B!0!1!2!3!5!6!7\"9J!A!B!C!D!E11!F!G1(1B!J\x12J(!" +
+	"+11!,\"/F!1\"21!3!4!7\"9J!A!B!C!D!E!F!G111D1E!J\x04:!:" +
+	"!\x12(!!(!+!,!1!3!4!5!6!7\":(!B!F!H\x12**\"1A!D!E\x12++!1\":" +
+	"+\"D(!E11!H!J\x12//!1!A1D!B!F!H\x1200!11A1E\":0!E11!H\x121(" +
+	"1D!+!/1B1J!1!2!3\"4E!6\":1!A!B1!1D!D!E!F1B!H!J\x1221!" +
+	"2\":2!D!H\x1231!3\":3!A!B!D!E!F1(!H\x134(D\"1E!4!E11!F!H!" +
+	"J\x125(!5\"D,1B!F\x136!D!(!1!6\":6!A11!F!H11!J\x137!D\"13141" +
+	"A!3!4!7\":7!A!D\"E417!H!J\x12A1!5!A!B!D!H!J\x13B:B!B\x12D(!" +
+	"*1J!+1J!,!3\"5E!7!8\":D!A1B!B!D!E!F!H!J\x12E*!,11!/\"0" +
+	"1!1!2!3!4!5!6!7\":E!B!D!E!F!H!J\x12F+11!,!5!6!8!E!F!" +
+	"J\x12G(\x12H+!,!1!2!3!5!7!:!D!H!J\x12J(!+!/1B!1!3!6!71D!8" +
+	"!A1B!B!D!E!F\"GB!J\x03A!*!/!1!3\"A!!B!D!E!H!J\x12*!!*!-!" +
+	".!115!4!:\"A*!B!C!D!F!H!J\x12+!!+!,!/!:!J\x12,!!,!1!3!4" +
+	"!9\"A,!D!E!F!H!J\x12-+!,!-!1!3!4!5!6\"A-!B!D!E!H!J\x12.*" +
+	"!,!.!0!1!2!4\"A.!D!E\x12/-!.!/!1!3!4!9!:1E\"A/!C!E!F!" +
+	"J\x1200\"A0\"DC\x121(1,!*1.1C1F!+1/!,1D1E1F!-!.!/13!1!21" +
+	"91D1F!31-1.!41-1/17!51E1F!6!71-141E!91F!:\"A1!B19" +
+	"!C!E1D\"F!131B!G1/!H12!J\x12211B!2!9\"A2\x123!!,!-!.!/!1" +
+	"\"A3!B\"CD!D!H\x124!!,!-!.!4!7!9!:\"A4!B!D!H\x125-!.!/!5!" +
+	"9\"A5!D!E!J\x126,!-!.!6!9!:\"A6!D!H\x127!!-!1!3!4\"A7!E!F" +
+	"!G!H\x1288!9!J\x1291\"A9!D!E1D!H\x12:1!:!E!H!J\x12B!!-1D!.!/!" +
+	"1!3!4!5!7!9\"AB!B!D!E!G!H\x12C1!9!C!D!F!G\x12D!!*!,!-13" +
+	"!.!0!31A!5!71-13!9!:\"AD!B1-17!C!D!E!H!J\x13F,D!-!.1" +
+	"1!/1314!3!41.1D1J!9\"AF!B!C!F!J\x12G/!113\"AG!B!E!G!H" +
+	"\x12H*!,!-!.!/!1!2!6!7!8!9!:!A!B!G\x12J!!,13!-131B!.!/" +
+	"\"3,!4!5!6!8!B!D1B1E!F\"G11B\x03B!(!E!J\x13(!F!(!+!-!1!3" +
+	"!5!6!7!9\"B(!D!F!H\x12*(!*!/!11/!9!D!E!F!H\x12+!!+!/!1\"" +
+	"B+!E!H!J\x12-(!+11!-!/1E\"0E!1!21D1E!5!71(11!A121D!D" +
+	"121A!E!H\x12.1!H\x12/-11!/!1!3!9!A!E!H!J\x120-!0!1!9111D!" +
+	"A\"B0!D!E!F!J\x121!!(19!*!+19!-!/1-1319!1\"2D1E!31E!4" +
+	"1-191E!51(191A1E!61(1E!71(131B1E!8!91(1+1A!A1517" +
+	"191D!B1113151A1E!E1/1415171D!F13151J!G!H!J\x122(11!" +
+	"-!2!9!D!E!F!H!J\x133!F!(11!-!1!3!711\"B3!E!F!H11\x124(!" +
+	"/!1!4!7!911!A\"B4!E!H11\x135!D!(1D!/!1!5!91D!A1D\"B5!" +
+	"D!E1D!H\x126!!(!6!9!A\"B6!E!J\x127(!11(1F!7!911!A\"B7!D!" +
+	"E!F!H\x139!D!(1D1J!+111D!/1/!117!2!31(1113!4!511\"6(" +
+	"!71(111D1F!8!9!A12\"B9!D!E13151D!F13!H1417\x12A!\"*D!" +
+	"-!.!/!1!2!3!414!5!71D!91D!A\"BA!D17!F!H!J\x12D(!*!-1" +
+	"E!.!/!21E!31J!5!91+1/171A1E!A1-!B1D!D!E19\"F3!H!J" +
+	"\x12E!\",1!-!.!/!115!2!3!4!5!711!91/171D!B1E!D!E!F!G" +
+	"1/!H!J\x12F!!(1D!*\"+D!-!/131D!2!311!4!5!71+11!9!A10" +
+	"14191D!E!F!H!J\x12G(1D!/!1!2\"B1191G!D!E12!G!H13!J\x12H" +
+	"(!*!-!.!/!1!219!3\"51!6!9131D!A!B1!131D!D1(!E!F!G" +
+	"!J\x12J!!+!-!/!1!3!5!6!8!9!A!B!D!E!F\x03C!(!,!/!3!4!5!" +
+	"A\"C!!D\"H/1D!J\x13(!F!(!*!+!-!/!11*!3!4!9\"C(!D!F!H\x13*" +
+	"!F!(!*!-!11E!9!A\"C*!D!E!F!G!H!J\x12+!!(!+!,!-!1!9!A" +
+	"\"C+!E\x12,,\x12-(!+!-!5!D\x12..!E\x12/!!,!-!/!1!3!4!9!A\"C/!E" +
+	"!F!G!H!J\x120(!0\x121(1,1/1314191D\"*(1-191E!+1!!,!/1-1" +
+	"31E!1!21E!3191A1E!4!51E!61E!8!9!A1!13!C1113!E\"F(" +
+	"1+1A!G1A!H!J\x122(!2\"9E\"C2!E1D!J\x123!!(!-!/!1!3!9!A!D" +
+	"!E!H\x124!!(!-!.!/!1!4!7!9!A\"C4!E11!H\x1255\"C5!E!J\x136C6" +
+	"\x128(!1!8\"C8!E!H\x129(111314!*11\"+(11!11E!2\"3(1E\"6D\"7" +
+	"D\"8D!9\"C9!D!E1112!F14!H\x12A!!*!-!.!1!3!A\"CA!D!F\"G1" +
+	"!J\x12D!12!(!*!+1E!-1(!/1/1J!2!31E\"4E\"5E!9!A!D!E131" +
+	"415\"G3!H!J\x12E!!*!-!.!/!1!2!311!4!911\"CE!D!E!F!G1D" +
+	"!J\x12F(1*14!*1!\"+!11!/!1!2!3!4!5!8!91+11!A14\"CF!F!" +
+	"G1A!H!J\x13G!(!(!/!11(!A\"CG!D!E13!F!G!J\x12H!1/1D!(!+1" +
+	"1!-!/1!1F!0!1!2!31,!4!911!A\"C(1J!D!E!F!G1/!J\x12J!!" +
+	"*!-!/!1!3!5!9!A!D!F!G\x03D!7!8!A!C\"D!!E!J\x12(!!(!*!+!" +
+	",!-!.!/!2!3!5!7!B!C\"D(!F!J\x12*!!(!*!-!/!2!E\x12+!!+!/" +
+	"!:!B\"D+!E!J\x12,!!(!,!0!A\"D,!E!F\x12-(!*!,!-!/!2!3!5!7" +
+	"!8!A!B!C\"D-!E!F!H1,!J\x12.(!.!5!A\"D.!E!F!H!J\x12//!3!:" +
+	"!C!E!F!J\x120,!0!9\"D0!E!J\x122!!(!,!-!2!B\"D2!E!F\x123(!/!" +
+	"3!9\"D3!E!F\x124H\x125(!5!:!A!B\"D5!H!J\x136D6!E!H\x127!!+!-!." +
+	"!3!7!9!A!E!G!H!J\x1288\"D8!J\x129(!+1E!,!2!3!5!6!7\"8E!9" +
+	"!A!B\"D9\"E8!F!H\x12:(!/!01E!2!7!A\"D:!E!H13\x12A!!*!+!,!" +
+	"-!.!8!9!A!B\"DA!E!H\x12B(!+!-!2!3!5!7!9!A!B\"DB!E!F!H" +
+	"!J\x12C!!+!-!/!2!4!9!C!E!F!J\x12E!!,!-!.!2!3!5!7!8!9!:" +
+	"!B!C!D1E!E!H!J\x12G!!(!+!,1E!/\"0E!21E!31E!7!9!A!B\"D" +
+	"!1G!E1,13!F!G!H1,1B\"J9\x12H(!*!+!,!-!.!/!0!2!3!5!7!" +
+	"8!9!:!A!B!C!E!F!G!H!J\x12J!!*!+!2!3!5!7!9!:!A!B!D!F" +
+	"!G\x03E!,!/!1!3!4!B!D\"E!!F!H!J\x12**!-!.!/!1!4!9!C!D\"E" +
+	"*!F!G!H!J\x12++!,!/!9!D\"E+!F\x12,,!-!/!1!3!9!D\"E,!F\x12-*" +
+	"!,!-!2!4!5!6!7!8!B!C!D\"E-!F!H!J\x12.,!.!11B!4!6!7!B" +
+	"\"E.!F!J\x12/-!.!/!1!3!4!B!D\"E/!F!G!J1F\x130!D!,!-1,!.!" +
+	"11B!9!B11!D\"E0!J\x121!1J!*!+!,!-!.1/!/!0!1!2!3!4!5!" +
+	"6!71D!9!:!B\"E1!F!G1E!J\x122,!-!1!2!9!B\"E2!F\"GD!H!J\x12" +
+	"3!!-!.!/!1!3!7!:!C!D\"E3!F!H!J\x124,!-!1!4!7!8!9!:!B" +
+	"!D\"E4!F!H!J\x125*!-!.!/!1!5!71C!9!D\"E5\x126-1D1F!1!6!:" +
+	"\"E6!H!J\x127!!-!.!1!3!7!9!B!D\"E7!G!H\x1288!9\x129*!,!/1/!" +
+	"1!212!3!5!6!7!9!B!C!D\"E9!F!H\x12:+!/!1!3!5!7!D\"E:\"F" +
+	"7!H!J\x12B*\"-3!1!3!7!9!B!D\"EB!G!H!J\x12C*!+!/!1!3!C!D\"" +
+	"EC!F1F!H\x12D!1,!+!,!-!.!/!0!2!3!4!5!7!9!:!B!C!D\"ED" +
+	"!G!H\x12F!!-!9!F\"G,!H!J\x12G,11!/!1!2!5!B!C!D\"EG!F!G!H" +
+	"!J\x12H!!*!+!,!1!4!5!:!B!D!E!F!G\x12J+!,!-!.!/!1!2!3!4" +
+	"!7!9!D!E!F!G\x03F!*!+!,!/!1!4!7!A!D!E1D\"F!!J\x12(!!(!*" +
+	"!+!,!-!.!011!1!2!3!4!5!6!7!9!:!B!C!D\"F(!G!H!J\x12*!" +
+	"!*!,!-!.!1!3!4!6!9!:!A!B!C!D!E!F1*!H\x12++!,!1!7!9!" +
+	"D!E\"F+!H!J\x12,!!(!+!,!-!.!/!0!1!2!3!4!9!A!D!E\"F,!G" +
+	"!H\x12-(!*!-!/!1!2!3!5!6!7!A!B!D!E\"F-!H!J\x12.(!,!.!0!" +
+	"11(!2!3!4!5!7!9!A!D!E\"F.!H\x12/!!(!-!.!/!1!3!4!5!9!" +
+	":!A!B!D!E!G!H\x120.!0!1!9!D\x122!!(!,!-!1!2!9!:!A!B!C!" +
+	"D\"F2!G!H\x123!!(!,!-!.!1!3!9!:!A!B!C!D!E\"F3!H!J\x124!!" +
+	"(!,!-!/!1!2!4!5!7!9!:!A!B!D!E\"F4!H11\x125(!*!-!1!5!" +
+	"9!A!D\"F5!H\x126(!,!-!.!/!1!6!A!D\"F6!H!J\x127(!-!1!3!7!" +
+	"9!A!B!D\"F7!H\x1281!A!E\x129(!*!+1D!,\"/D!1!3!4!5!6!7!81" +
+	"D!9!A!B!D!E\"F9!H!J\x12:(1B!*!1!2!4!5!6!A!B!D!E!J\x12A*" +
+	"!+!,!-!.!/!0!11,!2!3!4!5!6!7!9!:!A!B!D!G!J\x12B(!+!" +
+	"-!.!/!0!11/14!2!3!4!5!6!7!9!A!B!D!E\"FB!G!H!J\x12C!!" +
+	"(!*!+!-!.!/!1!2!3!4!5!8!9!A!D\"FC!G!J\x12E1!3!4!5!7!" +
+	":!B!D!E\"FE!G!H!J\x12G!!(1D!*11!,!/!11,!2!311!41D!6!" +
+	"7!A!B!C!D!E13\"GD!J\x12H!!(!*!,!-!.!/1!1D!1!2!3!4!5!" +
+	"6!7!9!A!B!C!D!E!F!G!J\x12J!!(!*!-!11(1,\"3(!5!6!7!9!" +
+	"A!B!C!D!G\x04G!G!\x12((!*!+!,!/!0!11,131E!2!4!5!7!9!:\"" +
+	"B9!C!D\"G(!H\x12*!!*!1!4!9!A!C!D1E!E111D1F!F\"G*!H\x12++" +
+	"!E11\"G+!J\x12,!!(!,!/!1!2!3!4!9!A!D!E!F\"G,!H!J\x12/!!(" +
+	"!,!/!1!4!:!A!C11!D!E1D!F\"G/!J\x120!!(\".1!0!11(1A1E!" +
+	"A\"C1!D1(!E!H!J\x121!!(10!*!,1(1D!/1(1D!1!2!3!41A!5!" +
+	"6!71E!9!A!B!E121317191D\"FA\"G1!H121D!J\x122!\"(11D!,!" +
+	"11B!2!9!A!B!D1,!E11\"G2!H\x1233!9\"G3\x1241!4!D!E\"G4!H\x125" +
+	"1!5!E\"G5!H\x126(!,!6!D!E\"G6!H\x127113!9!A!D1!13\"G7!H\x12A" +
+	"*!A!C\"GA!H\x12B9!A!B!D!E\"GB!J\x12C(!/!1!9!C!D!E!F\"GC!H" +
+	"\x12D(!*!,!/!2!3!9\"BE!C!D!E\"GD!H19\x12E!1C!*!,!/!0!11," +
+	"!2!3!4!5!7!9!:!B!C!D1,17!E\"GE!J\x12F!!(1*1315191:1D" +
+	"\"*(!,!/13!9!:!A!B!E!F\x12H!1F\"(1!*!,1D!/\"0D!1!2!3!4" +
+	"!9!C!D!E!F!G!J\x12J!!(!*!+!,!.!/!1!3!4!5!6!7!911!:!" +
+	"A!B\"CD!D1D!E1F!F1E!G\x03H!(!/!1!5!7!D!E\"H!!J\x12(!!.!/" +
+	"!1!4!5!7!9!:!B!D!G\x12*!!(!-!.!/!1!:!E!F!J\x12+!!(!,!1" +
+	"!:!A!B!D!E!F!J\x12,!!(!,!-!/!0!1!2!3!9!A!D!E!F!G!J\x12" +
+	"-,!/!1!4!5!A!D!E!F\"H-!J\x12./!2!4!5!6!7!A!E!F!J\x12/!!" +
+	",!-!/!1!3!9!A!B!C!D!F!G!J\x120!!-!1!9!A!D!E!F\"H0!J\x12" +
+	"1!!(!+!.!/!0!3!4!5!6!7!9!A!B!C!E!F!G\"H1!J\x122!!(!1" +
+	"!9!:!A!C!E!F\"H2!J\x123(!,!.!/!7!9!A!B!D!E!F\"H3!J\x124," +
+	"!-!1!2!8!9!:!B!C!D!E!F\"H4!J\x125!!(!/!5!9!A!D!E\"H5!" +
+	"J\x126!!-!.!1!9!A!E!F\x127!!-!.!/!3!4!7!A!E!F\"H7!J\x128(!" +
+	"A\x129(!+!/!1!2!3!8!A!B!C!D!E!F\"H9!J\x12:(!/!1!6!A!D!E" +
+	"!F\x12A/!1!2!6!B!D!G!J\x12B(!*!-!/!0!1!3!4!5!7!8!9!A!D" +
+	"!E!F!G\"HB!J\x12C!!(!*!+!-!/!1!2!3!8!9!A!D!E!F\"HC!J\x12" +
+	"D(!*!+!,!-!.!/!0!3!9!:!A!B!E!F!G\"HD!J\x12E!!/!2!3!6" +
+	"!B!F!G\x12F-!1!C!E!J\x12G(!*!+!,!/!1!2!3!4!5!7!A!B!D!E" +
+	"!F\"HG!J\x12JD\x03J!3\"J!\x12((!3\x12*E!F\x12,1\x12/9!G!J\x1211!9\"F!\x1231" +
+	"!3\x1255\x1291!7\"J9\x12A.!9\x12B8!B!F!G\x12DD\x12EE!F\x12F.!9\x12G*!1!E\"" +
+	"JG\x12H/!E\x04-H3("
@@ -0,0 +1,38 @@
+package constant
+
+// SENTENCE_TERMINATORS lists the punctuation marks ending a sentence: the Arabic question mark and full stop
+// (ARABIC FULL STOP, used in Urdu and Persian scripts too) and their Latin counterparts, plus the ellipsis.
+// The Arabic comma and semicolon separate clauses and never end a sentence.
+const SENTENCE_TERMINATORS = QUESTION + "۔" + ".!?…"
+
+// DEFAULT_ABBREVIATIONS lists the abbreviations whose final period does not end a sentence, written without it.
+var DEFAULT_ABBREVIATIONS = []string{
+	// Titles
+	"د",
+	"أ",
+	"م",
+	"ا.د",
+	"أ.د",
+	"م.م",
+	// Dates and references
+	"هـ",
+	"ه",
+	"ق.م",
+	"ص",
+	"ج",
+	"ط",
+	"ت",
+	// Latin abbreviations
+	"Dr",
+	"Mr",
+	"Mrs",
+	"Ms",
+	"Prof",
+	"St",
+	"vs",
+	"etc",
+	"e.g",
+	"i.e",
+	"p",
+	"No",
+}
@@ -0,0 +1,19 @@
+package constant
+
+// CONFUSABLE_LETTERS groups letters that are commonly mistaken for one another in OCR output and user-generated text,
+// mostly letters sharing the same skeleton and differing only by their dots.
+var CONFUSABLE_LETTERS = [][]string{
+	{BEH, TEH, THEH, NOON, YEH},
+	{JEEM, HAH, KHAH},
+	{DAL, THAL},
+	{REH, ZAIN},
+	{SEEN, SHEEN},
+	{SAD, DAD},
+	{TAH, ZAH},
+	{AIN, GHAIN},
+	{FEH, QAF},
+	{HEH, TEH_MARBUTA},
+	{YEH, ALEF_MAKSURA},
+	{ALEF, ALEF_HAMZA_ABOVE, ALEF_HAMZA_BELOW, ALEF_MADDA},
+	{WAW, WAW_HAMZA},
+}
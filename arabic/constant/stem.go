@@ -1,32 +1,5 @@
 package constant
 
-func init() {
-	for _, r := range FATHA {
-		TASHKEEL[r] = true
-	}
-	for _, r := range DAMMA {
-		TASHKEEL[r] = true
-	}
-	for _, r := range KASRA {
-		TASHKEEL[r] = true
-	}
-	for _, r := range SUKUN {
-		TASHKEEL[r] = true
-	}
-	for _, r := range SHADDA {
-		TASHKEEL[r] = true
-	}
-	for _, r := range FATHATAN {
-		TASHKEEL[r] = true
-	}
-	for _, r := range DAMMATAN {
-		TASHKEEL[r] = true
-	}
-	for _, r := range KASRATAN {
-		TASHKEEL[r] = true
-	}
-}
-
 const (
 	COMMA            = "\u060C"
 	SEMICOLON        = "\u061B"
@@ -111,8 +84,6 @@ const (
 	SIMPLE_LAM_ALEF_MADDA_ABOVE = "\u0644\u0622"
 )
 
-var TASHKEEL = map[rune]bool{}
-
 const (
 	DEFAULT_PREFIX_LETTERS = "مأسفلونيتاكب"
 	DEFAULT_SUFFIX_LETTERS = "امتةكنهوي"
@@ -121,6 +92,7 @@ const (
 	DEFAULT_MAX_SUFFIX     = 5
 	DEFAULT_MIN_STEM       = 3
 	DEFAULT_JOKER          = "*"
+	DEFAULT_SHORT_WORD     = 3
 )
 
 var DEFAULT_PREFIX_LIST = []string{
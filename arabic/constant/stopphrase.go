@@ -0,0 +1,28 @@
+package constant
+
+// DEFAULT_STOP_PHRASES lists multi-word particle phrases that carry no topic of their own,
+// removed as a whole along with the single-word stopwords.
+var DEFAULT_STOP_PHRASES = []string{
+	"على الرغم من",
+	"بالرغم من",
+	"من أجل",
+	"من خلال",
+	"عن طريق",
+	"بالإضافة إلى",
+	"إضافة إلى",
+	"فضلا عن",
+	"إلى جانب",
+	"في حين",
+	"في ظل",
+	"في إطار",
+	"بناء على",
+	"على سبيل المثال",
+	"على الأقل",
+	"على حد سواء",
+	"إلى حد ما",
+	"من حيث",
+	"من ثم",
+	"بغض النظر عن",
+	"بما في ذلك",
+	"في الوقت نفسه",
+}
@@ -0,0 +1,9 @@
+package constant
+
+// YEH_WEAK_ROOTS lists common hollow roots whose weak letter is YEH while the roots dictionary also holds the WAW
+// reading, e.g. خير for اختار rather than خور. The weak root resolver tries them before the default weak letter.
+var YEH_WEAK_ROOTS = []string{
+	"خير", "تيح", "سير", "طير", "بيع", "عيش", "صير", "زيد", "غيب", "بين",
+	"جيء", "ضيف", "سيل", "ريب", "شيب", "غير", "نيل", "كيل", "طيب", "فيض",
+	"صيد", "قيس", "قيد", "حير", "عيب", "ضيق", "بيت", "دين", "شيع",
+}
@@ -0,0 +1,122 @@
+package dictfile
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Magic starts the header line of a versioned dictionary file, which is followed by the dictionary content:
+//
+//	#arstem-dictionary v1 roots sha256=<hexadecimal SHA-256 of the content>
+//
+// Files without the header are loaded as they are, without any check.
+const Magic = "#arstem-dictionary"
+
+// Version is the version of the header written by Encode, and the only one Decode accepts.
+const Version = 1
+
+// Kind is the dictionary a file holds, recorded in its header so that a file can't be loaded as another dictionary.
+type Kind string
+
+const (
+	KindRoots          Kind = "roots"
+	KindPrefixes       Kind = "prefixes"
+	KindSuffixes       Kind = "suffixes"
+	KindVerbs          Kind = "verbs"
+	KindStopwords      Kind = "stopwords"
+	KindProtectedWords Kind = "protected"
+	KindExpressions    Kind = "expressions"
+)
+
+var (
+	// ErrMalformedHeader is returned for a header line that can't be parsed.
+	ErrMalformedHeader = errors.New("dictfile: malformed header")
+	// ErrUnknownVersion is returned for a header of a version this package doesn't read.
+	ErrUnknownVersion = errors.New("dictfile: unknown format version")
+	// ErrKindMismatch is returned when the header names another dictionary than the one loaded.
+	ErrKindMismatch = errors.New("dictfile: dictionary kind mismatch")
+	// ErrChecksumMismatch is returned when the content doesn't match the checksum of the header,
+	// e.g. after a truncated copy or a manual edit.
+	ErrChecksumMismatch = errors.New("dictfile: checksum mismatch")
+)
+
+// Header is the parsed header line of a versioned dictionary file.
+type Header struct {
+	Version int
+	Kind    Kind
+	// SHA256 is the hexadecimal SHA-256 checksum of the content following the header line.
+	SHA256 string
+}
+
+// Encode writes the content of a dictionary of the given kind preceded by its versioned header.
+func Encode(w io.Writer, kind Kind, content []byte) error {
+	sum := sha256.Sum256(content)
+	if _, err := fmt.Fprintf(w, "%s v%d %s sha256=%s\n", Magic, Version, kind, hex.EncodeToString(sum[:])); err != nil {
+		return err
+	}
+	_, err := w.Write(content)
+	return err
+}
+
+// Decode returns the content of a dictionary file of the given kind. If the file starts with a header,
+// its version, kind and checksum are checked and the header is removed; otherwise the file is returned unchanged.
+func Decode(data []byte, kind Kind) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte(Magic)) {
+		return data, nil
+	}
+	line, content, _ := bytes.Cut(data, []byte("\n"))
+	header, err := ParseHeader(string(line))
+	if err != nil {
+		return nil, err
+	}
+	if header.Kind != kind {
+		return nil, fmt.Errorf("%w: %s file loaded as %s", ErrKindMismatch, header.Kind, kind)
+	}
+	sum := sha256.Sum256(content)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), header.SHA256) {
+		return nil, ErrChecksumMismatch
+	}
+	return content, nil
+}
+
+// ReadFile reads a dictionary file of the given kind, checking and removing its header as Decode does.
+// Errors other than I/O errors mention the path.
+func ReadFile(path string, kind Kind) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := Decode(data, kind)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return content, nil
+}
+
+// ParseHeader parses a header line. It returns ErrUnknownVersion for a version other than Version,
+// so that files written by a newer release are rejected rather than misread.
+func ParseHeader(line string) (Header, error) {
+	fields := strings.Fields(strings.TrimSuffix(line, "\r"))
+	if len(fields) < 2 || fields[0] != Magic || !strings.HasPrefix(fields[1], "v") {
+		return Header{}, fmt.Errorf("%w: %q", ErrMalformedHeader, line)
+	}
+	version, err := strconv.Atoi(fields[1][1:])
+	if err != nil {
+		return Header{}, fmt.Errorf("%w: %q", ErrMalformedHeader, line)
+	}
+	if version != Version {
+		return Header{}, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	if len(fields) != 4 || !strings.HasPrefix(fields[3], "sha256=") {
+		return Header{}, fmt.Errorf("%w: %q", ErrMalformedHeader, line)
+	}
+	return Header{Version: version, Kind: Kind(fields[2]), SHA256: strings.TrimPrefix(fields[3], "sha256=")}, nil
+}
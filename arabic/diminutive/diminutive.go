@@ -0,0 +1,139 @@
+package diminutive
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"strings"
+)
+
+// Diminutive holds the result of diminutive (تصغير) analysis.
+type Diminutive struct {
+	// Word is the analyzed word, without tashkeel.
+	Word string
+	// Prefix holds the proclitics and the definite article before the diminutive, e.g. ال in الكتيب.
+	Prefix string
+	// Stem is the diminutive itself, keeping its infix YEH, e.g. كتيب in كتيبات.
+	Stem string
+	// Suffix holds the feminine or plural ending and the attached pronoun, e.g. ات in كتيبات.
+	Suffix string
+	// Pattern is the template of the stem: فعيل, فعيعل or فعيعيل.
+	Pattern string
+	// Root is the root of the diminutive, e.g. كتب for كتيب or درهم for دريهم.
+	Root string
+}
+
+type DiminutiveAnalyzer interface {
+	Analyze(word string) (Diminutive, bool)
+}
+
+// diminutiveAnalyzer recognizes diminutives of the فعيل, فعيعل and فعيعيل patterns.
+type diminutiveAnalyzer struct {
+	known        map[string]bool
+	rootsManager roots.RootsManager
+}
+
+// Templates of the diminutive, by length of the stem.
+var (
+	triliteralPattern        = constant.FEH + constant.AIN + constant.YEH + constant.LAM
+	quadriliteralPattern     = constant.FEH + constant.AIN + constant.YEH + constant.AIN + constant.LAM
+	longQuadriliteralPattern = constant.FEH + constant.AIN + constant.YEH + constant.AIN + constant.YEH + constant.LAM
+)
+
+// diminutiveSuffixes lists the endings a diminutive may carry, longest first.
+var diminutiveSuffixes = []string{"اتها", "اتهم", "تها", "تان", "تين", "ات", "ان", "ين", "ون", "ته", "ها", "هم", "ة", "ه", "ي"}
+
+// NewDiminutiveAnalyzer creates a new instance of DiminutiveAnalyzer with the provided known diminutives
+// and RootsManager. The RootsManager is used to check the root of the pattern.
+func NewDiminutiveAnalyzer(known []string, rootsManager roots.RootsManager) DiminutiveAnalyzer {
+	da := &diminutiveAnalyzer{
+		known:        make(map[string]bool, len(known)),
+		rootsManager: rootsManager,
+	}
+	for _, word := range known {
+		da.known[word] = true
+	}
+	return da
+}
+
+// Analyze checks whether the given word, with or without tashkeel, is a diminutive and returns its parts and root.
+// The فعيل pattern is shared with adjectives (كبير), so a triliteral diminutive is only recognized when it is one of
+// the known diminutives, or when its vowels tell it, a damma on its first letter or a shadda on its YEH, e.g. كُتَيِّب.
+// Quadriliteral diminutives, such as دريهم, are recognized whenever their root is in the dictionary.
+func (da *diminutiveAnalyzer) Analyze(word string) (Diminutive, bool) {
+	unvocalized := normalize.StripTashkeel(word)
+	// The proclitics ب and ك may also be the first letter of the stem, e.g. كتيب
+	var candidates []Diminutive
+	for _, prefix := range append([]string{""}, constant.NOUN_PROCLITICS...) {
+		rest, ok := strings.CutPrefix(unvocalized, prefix)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, Diminutive{Prefix: prefix, Stem: rest})
+		for _, suffix := range diminutiveSuffixes {
+			if stem := strings.TrimSuffix(rest, suffix); stem != rest {
+				candidates = append(candidates, Diminutive{Prefix: prefix, Stem: stem, Suffix: suffix})
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		pattern, root, ok := da.match(candidate.Stem)
+		if !ok {
+			continue
+		}
+		if pattern == triliteralPattern && !da.known[candidate.Stem] && !vocalizedDiminutive(word, len([]rune(candidate.Prefix))) {
+			continue
+		}
+		candidate.Word, candidate.Pattern, candidate.Root = unvocalized, pattern, root
+		return candidate, true
+	}
+	return Diminutive{}, false
+}
+
+// match returns the template matched by the stem and its root, if the root is in the dictionary.
+func (da *diminutiveAnalyzer) match(stem string) (string, string, bool) {
+	letters := []rune(stem)
+	if len(letters) < 4 || string(letters[2]) != constant.YEH || strings.HasSuffix(stem, constant.TEH_MARBUTA) {
+		return "", "", false
+	}
+	var pattern string
+	var root []rune
+	switch len(letters) {
+	case 4:
+		pattern, root = triliteralPattern, []rune{letters[0], letters[1], letters[3]}
+	case 5:
+		pattern, root = quadriliteralPattern, []rune{letters[0], letters[1], letters[3], letters[4]}
+	case 6:
+		if string(letters[4]) != constant.YEH {
+			return "", "", false
+		}
+		pattern, root = longQuadriliteralPattern, []rune{letters[0], letters[1], letters[3], letters[5]}
+	default:
+		return "", "", false
+	}
+	normalized := da.rootsManager.NormalizeRoot(string(root))
+	if !da.rootsManager.IsRoot(normalized) {
+		return "", "", false
+	}
+	return pattern, normalized, true
+}
+
+// vocalizedDiminutive reports whether the vowels of the stem starting at the letter index tell a diminutive:
+// a damma on its first letter, or a shadda on its YEH.
+func vocalizedDiminutive(word string, start int) bool {
+	var marks []string
+	for _, char := range word {
+		if normalize.IsTashkeel(char) {
+			if len(marks) > 0 {
+				marks[len(marks)-1] += string(char)
+			}
+			continue
+		}
+		marks = append(marks, "")
+	}
+	if len(marks) < start+3 {
+		return false
+	}
+	return strings.Contains(marks[start], constant.DAMMA) || strings.Contains(marks[start+2], constant.SHADDA)
+}
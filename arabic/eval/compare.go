@@ -0,0 +1,63 @@
+package eval
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+)
+
+// WordDiff records a word on which the compared stemmers disagree. Stems are listed in the order of the stemmers.
+type WordDiff struct {
+	Word  string
+	Stems []string
+}
+
+// ComparisonReport summarizes how often several stemmers agree on the same words.
+type ComparisonReport struct {
+	Words int
+	// Agreement is the proportion of words on which all stemmers return the same stem.
+	Agreement float64
+	// PairwiseAgreement holds, at [i][j], the proportion of words on which stemmers i and j return the same stem.
+	PairwiseAgreement [][]float64
+	Diffs             []WordDiff
+}
+
+// Compare runs every stemmer over the same words and reports their agreement rates and per-word differences.
+// It helps choosing between configurations, such as light stemming with or without nisba stripping.
+func Compare(stemmers []stemmer.Stemmer, words []string) ComparisonReport {
+	report := ComparisonReport{Words: len(words), PairwiseAgreement: make([][]float64, len(stemmers))}
+	pairAgreed := make([][]int, len(stemmers))
+	for i := range stemmers {
+		pairAgreed[i] = make([]int, len(stemmers))
+		report.PairwiseAgreement[i] = make([]float64, len(stemmers))
+	}
+
+	agreed := 0
+	for _, word := range words {
+		stems := make([]string, len(stemmers))
+		for i, s := range stemmers {
+			stems[i] = s.LightStem(word)
+		}
+		allAgree := true
+		for i := range stems {
+			for j := range stems {
+				if stems[i] == stems[j] {
+					pairAgreed[i][j]++
+				} else {
+					allAgree = false
+				}
+			}
+		}
+		if allAgree {
+			agreed++
+		} else {
+			report.Diffs = append(report.Diffs, WordDiff{Word: word, Stems: stems})
+		}
+	}
+
+	report.Agreement = ratio(agreed, len(words))
+	for i := range stemmers {
+		for j := range stemmers {
+			report.PairwiseAgreement[i][j] = ratio(pairAgreed[i][j], len(words))
+		}
+	}
+	return report
+}
@@ -0,0 +1,104 @@
+package eval
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrMissingWordColumn is returned when a dataset header has no "word" column.
+var ErrMissingWordColumn = errors.New("eval: dataset header has no word column")
+
+// Entry is a gold-standard annotation of a word. An empty Stem or Root means the word isn't annotated for it.
+type Entry struct {
+	Word string
+	Stem string
+	Root string
+}
+
+// LoadCSV reads a gold-standard dataset from CSV data.
+// If the first record contains a "word" column, it is read as a header naming the word, stem and root columns in any order.
+// Otherwise, records are read as word, stem and an optional root. Blank words are skipped.
+func LoadCSV(r io.Reader) ([]Entry, error) {
+	return loadDelimited(r, ',')
+}
+
+// loadDelimited reads a gold-standard dataset whose fields are separated by the given delimiter.
+func loadDelimited(r io.Reader, delimiter rune) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = delimiter
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := map[string]int{"word": 0, "stem": 1, "root": 2}
+	if isHeader(records[0]) {
+		columns = map[string]int{"word": -1, "stem": -1, "root": -1}
+		for i, name := range records[0] {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if _, known := columns[name]; known {
+				columns[name] = i
+			}
+		}
+		if columns["word"] < 0 {
+			return nil, ErrMissingWordColumn
+		}
+		records = records[1:]
+	}
+
+	var entries []Entry
+	for _, record := range records {
+		entry := Entry{
+			Word: field(record, columns["word"]),
+			Stem: field(record, columns["stem"]),
+			Root: field(record, columns["root"]),
+		}
+		if entry.Word != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// LoadFile reads a gold-standard dataset from a CSV file. See LoadCSV for the expected format.
+func LoadFile(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries, err := LoadCSV(file)
+	if err != nil {
+		return nil, fmt.Errorf("eval: loading %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// isHeader checks if the record names a "word" column.
+func isHeader(record []string) bool {
+	for _, name := range record {
+		if strings.EqualFold(strings.TrimSpace(name), "word") {
+			return true
+		}
+	}
+	return false
+}
+
+// field returns the trimmed value of the column at index i, or an empty string if the record doesn't have it.
+func field(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
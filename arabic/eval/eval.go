@@ -0,0 +1,69 @@
+package eval
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+)
+
+// Mismatch records a word whose stem or root differs from the gold standard.
+type Mismatch struct {
+	Word string
+	// Kind is either "stem" or "root".
+	Kind     string
+	Expected string
+	Got      string
+}
+
+// Report summarizes the evaluation of a stemmer against a gold-standard dataset.
+type Report struct {
+	// StemTotal and RootTotal are the numbers of entries annotated with a stem and a root.
+	StemTotal int
+	RootTotal int
+	// StemAccuracy and RootAccuracy are the proportions of annotated entries answered correctly.
+	StemAccuracy float64
+	RootAccuracy float64
+	Mismatches   []Mismatch
+}
+
+// Evaluate runs the stemmer over the dataset and compares its stems and roots with the gold standard.
+// Both sides are compared without tashkeel, and roots are compared in the normalized form of the roots dictionary.
+// Understemming and overstemming are measured by Paice, over groups of words sharing a stem.
+func Evaluate(als *stemmer.ArabicLightStemmer, entries []Entry) Report {
+	report := Report{}
+	var stemCorrect, rootCorrect int
+
+	for _, entry := range entries {
+		if entry.Stem != "" {
+			report.StemTotal++
+			expected := normalize.StripTashkeel(entry.Stem)
+			got := als.LightStem(entry.Word)
+			if got == expected {
+				stemCorrect++
+			} else {
+				report.Mismatches = append(report.Mismatches, Mismatch{Word: entry.Word, Kind: "stem", Expected: expected, Got: got})
+			}
+		}
+		if entry.Root != "" {
+			report.RootTotal++
+			expected := normalize.CollapseHamza(normalize.StripTashkeel(entry.Root))
+			got := als.Root(entry.Word)
+			if normalize.CollapseHamza(got) == expected {
+				rootCorrect++
+			} else {
+				report.Mismatches = append(report.Mismatches, Mismatch{Word: entry.Word, Kind: "root", Expected: entry.Root, Got: got})
+			}
+		}
+	}
+
+	report.StemAccuracy = ratio(stemCorrect, report.StemTotal)
+	report.RootAccuracy = ratio(rootCorrect, report.RootTotal)
+	return report
+}
+
+// ratio returns n divided by total, or zero if total is zero.
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
@@ -0,0 +1,107 @@
+package eval
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"sort"
+)
+
+// PaiceReport holds Paice's stemming metrics, computed from groups of words that should share a stem,
+// e.g. the inflected forms of a lemma. Totals count pairs of words.
+type PaiceReport struct {
+	// DesiredMerges is the number of pairs of words in the same group, and UnachievedMerges those given different stems.
+	DesiredMerges    float64
+	UnachievedMerges float64
+	// DesiredNonMerges is the number of pairs of words in different groups, and WrongMerges those given the same stem.
+	DesiredNonMerges float64
+	WrongMerges      float64
+	// UnderStemming is the understemming index UI, UnachievedMerges divided by DesiredMerges.
+	UnderStemming float64
+	// OverStemming is the overstemming index OI, WrongMerges divided by DesiredNonMerges.
+	OverStemming float64
+	// StemmingWeight is OverStemming divided by UnderStemming. Heavier stemmers have higher weights.
+	// It is zero when UnderStemming is.
+	StemmingWeight float64
+}
+
+// Paice stems every word of the groups and computes Paice's understemming and overstemming indices and
+// stemming weight. A word should appear in a single group.
+func Paice(s stemmer.Stemmer, groups [][]string) PaiceReport {
+	total := 0
+	for _, group := range groups {
+		total += len(group)
+	}
+
+	var report PaiceReport
+	// classes counts, for every stem, the words of each group given that stem
+	classes := make(map[string]map[int]int)
+	for g, group := range groups {
+		n := float64(len(group))
+		report.DesiredMerges += n * (n - 1) / 2
+		report.DesiredNonMerges += n * float64(total-len(group)) / 2
+
+		stems := make(map[string]int)
+		for _, word := range group {
+			stem := s.LightStem(word)
+			stems[stem]++
+			if classes[stem] == nil {
+				classes[stem] = make(map[int]int)
+			}
+			classes[stem][g]++
+		}
+		report.UnachievedMerges += splitPairs(stems, len(group))
+	}
+	for _, counts := range classes {
+		size := 0
+		for _, count := range counts {
+			size += count
+		}
+		report.WrongMerges += splitPairs(counts, size)
+	}
+
+	report.UnderStemming = ratioFloat(report.UnachievedMerges, report.DesiredMerges)
+	report.OverStemming = ratioFloat(report.WrongMerges, report.DesiredNonMerges)
+	report.StemmingWeight = ratioFloat(report.OverStemming, report.UnderStemming)
+	return report
+}
+
+// GroupByStem groups the words of the entries annotated with a stem by their stem, compared without tashkeel,
+// giving the groups expected by Paice. Groups are ordered by stem, and words keep the order of the entries.
+func GroupByStem(entries []Entry) [][]string {
+	byStem := make(map[string][]string)
+	for _, entry := range entries {
+		if entry.Stem == "" {
+			continue
+		}
+		stem := normalize.StripTashkeel(entry.Stem)
+		byStem[stem] = append(byStem[stem], entry.Word)
+	}
+	stems := make([]string, 0, len(byStem))
+	for stem := range byStem {
+		stems = append(stems, stem)
+	}
+	sort.Strings(stems)
+	groups := make([][]string, len(stems))
+	for i, stem := range stems {
+		groups[i] = byStem[stem]
+	}
+	return groups
+}
+
+// splitPairs returns the number of pairs of a set of the given size whose members fall in different parts,
+// given the size of each part.
+func splitPairs[K comparable](parts map[K]int, size int) float64 {
+	pairs := 0.0
+	for _, count := range parts {
+		pairs += float64(count) * float64(size-count)
+	}
+	return pairs / 2
+}
+
+// ratioFloat returns n divided by total, or zero if total is zero.
+func ratioFloat(n, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return n / total
+}
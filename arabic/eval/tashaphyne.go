@@ -0,0 +1,73 @@
+package eval
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"io"
+)
+
+// Divergence records a word whose stem or root differs from the output of the original Python Tashaphyne.
+type Divergence struct {
+	Word           string
+	TashaphyneStem string
+	Stem           string
+	TashaphyneRoot string
+	Root           string
+}
+
+// CompatibilityReport summarizes how closely the stemmer reproduces Tashaphyne outputs.
+type CompatibilityReport struct {
+	Total         int
+	StemAgreement float64
+	RootAgreement float64
+	Divergences   []Divergence
+}
+
+// LoadTashaphyneFixtures reads tab-separated Tashaphyne outputs: one word per line followed by its stem and root.
+// Such fixtures can be produced in Python with the tashaphyne package:
+//
+//	stemmer = ArabicLightStemmer()
+//	for word in words:
+//	    print(word, stemmer.light_stem(word), stemmer.get_root(), sep="\t")
+//
+// A header naming the word, stem and root columns is accepted, as with LoadCSV.
+func LoadTashaphyneFixtures(r io.Reader) ([]Entry, error) {
+	return loadDelimited(r, '\t')
+}
+
+// CompareTashaphyne runs the stemmer over the fixtures and reports every word whose stem or root differs
+// from the Tashaphyne output. Outputs are compared verbatim, as users migrating from Python need exact parity.
+// Fixtures without a stem or root are only compared on the outputs they have.
+func CompareTashaphyne(als *stemmer.ArabicLightStemmer, fixtures []Entry) CompatibilityReport {
+	report := CompatibilityReport{Total: len(fixtures)}
+	var stemTotal, stemAgreed, rootTotal, rootAgreed int
+
+	for _, fixture := range fixtures {
+		divergence := Divergence{Word: fixture.Word, TashaphyneStem: fixture.Stem, TashaphyneRoot: fixture.Root}
+		diverges := false
+		if fixture.Stem != "" {
+			stemTotal++
+			divergence.Stem = als.LightStem(fixture.Word)
+			if divergence.Stem == fixture.Stem {
+				stemAgreed++
+			} else {
+				diverges = true
+			}
+		}
+		if fixture.Root != "" {
+			rootTotal++
+			divergence.Root = als.Root(fixture.Word)
+			if divergence.Root == fixture.Root {
+				rootAgreed++
+			} else {
+				diverges = true
+			}
+		}
+		if diverges {
+			report.Divergences = append(report.Divergences, divergence)
+		}
+	}
+
+	report.StemAgreement = ratio(stemAgreed, stemTotal)
+	report.RootAgreement = ratio(rootAgreed, rootTotal)
+	return report
+}
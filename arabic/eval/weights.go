@@ -0,0 +1,19 @@
+package eval
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+)
+
+// LearnWeights estimates affix weights from the entries annotated with a stem, for use with stemmer.StrategyWeighted.
+// Words and stems are compared without tashkeel.
+func LearnWeights(entries []Entry) affix.Weights {
+	examples := make([]affix.Example, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Stem == "" {
+			continue
+		}
+		examples = append(examples, affix.Example{Word: normalize.StripTashkeel(entry.Word), Stem: normalize.StripTashkeel(entry.Stem)})
+	}
+	return affix.Learn(examples)
+}
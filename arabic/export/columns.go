@@ -0,0 +1,114 @@
+package export
+
+import (
+	"bufio"
+	"encoding/gob"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"io"
+	"strings"
+)
+
+// DefaultBatchSize is the number of tokens per batch written by WriteCorpusColumns when none is given.
+const DefaultBatchSize = 65536
+
+// TokenColumns holds a batch of corpus tokens column by column, the layout of columnar formats such as Parquet
+// or Arrow. Every column has one value per token.
+type TokenColumns struct {
+	// Doc is the index of the document holding the token, from 0, and Offset the byte offset of the token in it,
+	// or -1 if the token isn't found as written, e.g. when it held invisible characters.
+	Doc    []int64
+	Offset []int64
+	Word   []string
+	Stem   []string
+	Root   []string
+}
+
+// Append adds a token to the columns.
+func (tc *TokenColumns) Append(doc, offset int, word, stem, root string) {
+	tc.Doc = append(tc.Doc, int64(doc))
+	tc.Offset = append(tc.Offset, int64(offset))
+	tc.Word = append(tc.Word, word)
+	tc.Stem = append(tc.Stem, stem)
+	tc.Root = append(tc.Root, root)
+}
+
+// Len returns the number of tokens of the columns.
+func (tc *TokenColumns) Len() int {
+	return len(tc.Word)
+}
+
+// Reset empties the columns, keeping their capacity.
+func (tc *TokenColumns) Reset() {
+	tc.Doc, tc.Offset = tc.Doc[:0], tc.Offset[:0]
+	tc.Word, tc.Stem, tc.Root = tc.Word[:0], tc.Stem[:0], tc.Root[:0]
+}
+
+// ColumnWriter writes batches of token columns to a columnar output. Implement it on top of a Parquet or Arrow
+// library to load the results of WriteCorpusColumns directly into an analytics stack.
+type ColumnWriter interface {
+	// WriteColumns writes a batch. The columns are reused once it returns, so they must not be retained.
+	WriteColumns(columns TokenColumns) error
+	// Close flushes the output. It doesn't close the underlying writer.
+	Close() error
+}
+
+// gobColumnWriter writes the batches as a stream of gob encoded TokenColumns values.
+type gobColumnWriter struct {
+	w       *bufio.Writer
+	encoder *gob.Encoder
+}
+
+// NewGobColumnWriter creates a ColumnWriter encoding every batch as a TokenColumns value with encoding/gob.
+// Decode the output with a gob.Decoder into TokenColumns values until io.EOF.
+func NewGobColumnWriter(w io.Writer) ColumnWriter {
+	buffered := bufio.NewWriter(w)
+	return &gobColumnWriter{w: buffered, encoder: gob.NewEncoder(buffered)}
+}
+
+func (gw *gobColumnWriter) WriteColumns(columns TokenColumns) error {
+	return gw.encoder.Encode(columns)
+}
+
+func (gw *gobColumnWriter) Close() error {
+	return gw.w.Flush()
+}
+
+// WriteCorpusColumns reads a corpus holding one document per line, tokenizes and stems it, and writes its tokens
+// to the column writer in batches of batchSize tokens, or DefaultBatchSize if batchSize isn't positive.
+// The column writer is closed once the corpus is read.
+func WriteCorpusColumns(als *stemmer.ArabicLightStemmer, r io.Reader, cw ColumnWriter, batchSize int) error {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	var columns TokenColumns
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for doc := 0; scanner.Scan(); doc++ {
+		line := scanner.Text()
+		cursor := 0
+		for _, token := range als.Tokenize(line) {
+			offset := -1
+			if i := strings.Index(line[cursor:], token); i >= 0 {
+				offset = cursor + i
+				cursor = offset + len(token)
+			}
+			segmentation := als.StemSegmentation(token)
+			columns.Append(doc, offset, token, segmentation.Stem, segmentation.Root)
+			if columns.Len() == batchSize {
+				if err := cw.WriteColumns(columns); err != nil {
+					return err
+				}
+				columns.Reset()
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if columns.Len() > 0 {
+		if err := cw.WriteColumns(columns); err != nil {
+			return err
+		}
+	}
+	return cw.Close()
+}
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"io"
+	"sort"
+)
+
+// PostgresFormat selects the PostgreSQL text search dictionary template an export is written for.
+type PostgresFormat int
+
+const (
+	// PostgresSynonym writes a synonym dictionary file (.syn), one "word stem" pair per line.
+	PostgresSynonym PostgresFormat = iota
+	// PostgresThesaurus writes a thesaurus dictionary file (.ths), one "word : stem" entry per line.
+	PostgresThesaurus
+)
+
+// WritePostgresDictionary writes the light stems of the given vocabulary as a PostgreSQL text search dictionary file,
+// so that a database can reproduce the stemmer's behavior without running Go code. Words are stripped of tashkeel,
+// deduplicated and sorted; words without a stem or whose stem is the word itself are left out, as PostgreSQL passes
+// unknown words on to the next dictionary unchanged. Once the file is copied to $SHAREDIR/tsearch_data/arabic_stem.syn:
+//
+//	CREATE TEXT SEARCH DICTIONARY arabic_stem (TEMPLATE = synonym, SYNONYMS = arabic_stem);
+func WritePostgresDictionary(w io.Writer, als *stemmer.ArabicLightStemmer, vocabulary []string, format PostgresFormat) error {
+	stems := make(map[string]string)
+	for _, word := range vocabulary {
+		for _, token := range als.Tokenize(word) {
+			unvocalized := normalize.StripTashkeel(token)
+			if _, exists := stems[unvocalized]; exists {
+				continue
+			}
+			stems[unvocalized] = als.LightStem(unvocalized)
+		}
+	}
+
+	words := make([]string, 0, len(stems))
+	for word, stem := range stems {
+		if stem != "" && stem != word {
+			words = append(words, word)
+		}
+	}
+	sort.Strings(words)
+
+	separator := " "
+	if format == PostgresThesaurus {
+		separator = " : "
+	}
+	writer := bufio.NewWriter(w)
+	for _, word := range words {
+		if _, err := fmt.Fprintf(writer, "%s%s%s\n", word, separator, stems[word]); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,97 @@
+package geminate
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"strings"
+	"unicode/utf8"
+)
+
+// Rules controls how geminated (doubled) roots such as مدد and ردد are handled during root extraction.
+type Rules struct {
+	// ExpandShadda writes out letters carrying a shadda twice before root extraction, e.g. مدّ → مدد. The expanded
+	// reading is only kept when it gives a geminated dictionary root, so that the shadda of the derived forms
+	// such as معلّم or كسّر isn't read as a doubled root letter.
+	ExpandShadda bool
+	// DoubleBiliteral completes a two-letter root made of root letters only by doubling its last letter.
+	DoubleBiliteral bool
+	// PreferGeminated tries the geminated reading of a two-letter root before its weak-root readings.
+	PreferGeminated bool
+}
+
+// DefaultRules returns the rules used by the stemmer by default: shadda is expanded and two-letter roots
+// are doubled when no weak-root reading is found in the dictionary.
+func DefaultRules() Rules {
+	return Rules{
+		ExpandShadda:    true,
+		DoubleBiliteral: true,
+		PreferGeminated: false,
+	}
+}
+
+// ExpandShadda replaces each SHADDA with a copy of the letter it is written on, so that the doubling
+// survives tashkeel stripping. Other diacritics are kept; the shadda may come before or after the short vowel.
+// The shadda of a sun letter following the definite article marks the assimilation of the article rather than
+// a doubled root letter, e.g. الشَّمس, and is kept as it is.
+func ExpandShadda(word string) string {
+	expanded, _ := expandShadda(word)
+	return expanded
+}
+
+// ShaddaLetters returns the letters ExpandShadda writes out twice, in the order of the word, e.g. د for مدّ.
+func ShaddaLetters(word string) string {
+	_, letters := expandShadda(word)
+	return letters
+}
+
+// expandShadda returns the word with its shadda expanded along with the letters that were doubled.
+func expandShadda(word string) (string, string) {
+	if !strings.Contains(word, constant.SHADDA) {
+		return word, ""
+	}
+	shadda := []rune(constant.SHADDA)[0]
+	assimilated := articleEnd(normalize.StripTashkeel(word))
+
+	var result, doubled strings.Builder
+	var last rune
+	letters := 0
+	for _, char := range word {
+		if char == shadda {
+			switch {
+			case letters-1 == assimilated && strings.ContainsRune(constant.SUN_LETTERS, last):
+				result.WriteRune(char)
+			case last != 0:
+				result.WriteRune(last)
+				doubled.WriteRune(last)
+			}
+			continue
+		}
+		if !normalize.IsTashkeel(char) {
+			last = char
+			letters++
+		}
+		result.WriteRune(char)
+	}
+	return result.String(), doubled.String()
+}
+
+// articleEnd returns the position of the letter following the definite article the word begins with,
+// possibly joined with proclitics, or -1 if the word doesn't begin with the article.
+func articleEnd(word string) int {
+	for _, article := range constant.DEFINITE_ARTICLES {
+		if strings.HasPrefix(word, article) && len(word) > len(article) {
+			return utf8.RuneCountInString(article)
+		}
+	}
+	return -1
+}
+
+// Geminate completes a two-letter root by doubling its last letter, e.g. مد → مدد.
+// Roots of any other length are returned unchanged.
+func Geminate(root string) string {
+	runeRoot := []rune(root)
+	if len(runeRoot) != 2 {
+		return root
+	}
+	return root + string(runeRoot[1])
+}
@@ -0,0 +1,61 @@
+package geminate
+
+import "testing"
+
+func TestExpandShadda(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"مدّ", "مدد"},
+		{"مَدَّ", "مَدَد"},
+		{"شدّة", "شددة"},
+		{"المدّ", "المدد"},
+		{"الشَّمس", "الشَّمس"},
+		{"الدّرس", "الدّرس"},
+		{"النّور", "النّور"},
+		{"للشّمس", "للشّمس"},
+		{"والرّدّ", "والرّدد"},
+		{"كتاب", "كتاب"},
+	}
+	for _, tt := range tests {
+		if got := ExpandShadda(tt.word); got != tt.want {
+			t.Errorf("ExpandShadda(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestShaddaLetters(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"مدّ", "د"},
+		{"معلّم", "ل"},
+		{"الشَّمس", ""},
+		{"والرّدّ", "د"},
+		{"كتاب", ""},
+	}
+	for _, tt := range tests {
+		if got := ShaddaLetters(tt.word); got != tt.want {
+			t.Errorf("ShaddaLetters(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestGeminate(t *testing.T) {
+	tests := []struct {
+		root string
+		want string
+	}{
+		{"مد", "مدد"},
+		{"رد", "ردد"},
+		{"كتب", "كتب"},
+		{"م", "م"},
+	}
+	for _, tt := range tests {
+		if got := Geminate(tt.root); got != tt.want {
+			t.Errorf("Geminate(%q) = %q, want %q", tt.root, got, tt.want)
+		}
+	}
+}
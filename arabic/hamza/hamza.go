@@ -0,0 +1,78 @@
+package hamza
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+)
+
+var (
+	hamza          = []rune(constant.HAMZA)[0]
+	alef           = []rune(constant.ALEF)[0]
+	alefHamzaAbove = []rune(constant.ALEF_HAMZA_ABOVE)[0]
+	wawHamza       = []rune(constant.WAW_HAMZA)[0]
+	yehHamza       = []rune(constant.YEH_HAMZA)[0]
+	waw            = []rune(constant.WAW)[0]
+	yeh            = []rune(constant.YEH)[0]
+	alefMaksura    = []rune(constant.ALEF_MAKSURA)[0]
+	tehMarbuta     = []rune(constant.TEH_MARBUTA)[0]
+)
+
+// RestoreRoot restores the hamza seats of a root written with bare hamza, as found in the roots dictionary.
+// Roots are displayed in the form of their past tense verb (فَعَلَ), so a hamza is written on ALEF
+// unless it follows a long vowel, in which case it stays bare: سءل → سأل، قرء → قرأ، ءكل → أكل.
+func RestoreRoot(root string) string {
+	runeRoot := []rune(root)
+	for i, char := range runeRoot {
+		if char != hamza {
+			continue
+		}
+		if i > 0 && isLongVowel(runeRoot[i-1]) {
+			continue
+		}
+		runeRoot[i] = alefHamzaAbove
+	}
+	return string(runeRoot)
+}
+
+// RestoreStem restores the seat of each bare hamza in an unvocalized stem using context rules.
+// An initial hamza is written on ALEF. A medial hamza is written on YEH next to a YEH, on WAW next to a WAW,
+// stays bare between ALEF and TEH MARBUTA, and is written on ALEF otherwise. A final hamza stays bare after
+// a long vowel and is written on ALEF otherwise. Hamzas that already have a seat are left unchanged.
+func RestoreStem(stem string) string {
+	runeStem := []rune(stem)
+	last := len(runeStem) - 1
+	for i, char := range runeStem {
+		if char != hamza {
+			continue
+		}
+		var prev, next rune
+		if i > 0 {
+			prev = runeStem[i-1]
+		}
+		if i < last {
+			next = runeStem[i+1]
+		}
+
+		switch {
+		case i == 0:
+			runeStem[i] = alefHamzaAbove
+		case i == last:
+			if !isLongVowel(prev) {
+				runeStem[i] = alefHamzaAbove
+			}
+		case prev == yeh || next == yeh:
+			runeStem[i] = yehHamza
+		case prev == waw || next == waw:
+			runeStem[i] = wawHamza
+		case prev == alef && next == tehMarbuta:
+			// قراءة keeps a bare hamza after the long ALEF
+		default:
+			runeStem[i] = alefHamzaAbove
+		}
+	}
+	return string(runeStem)
+}
+
+// isLongVowel checks if the given letter can carry a long vowel (ALEF, WAW, YEH or ALEF MAKSURA).
+func isLongVowel(char rune) bool {
+	return char == alef || char == waw || char == yeh || char == alefMaksura
+}
@@ -0,0 +1,34 @@
+package indexkey
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"sync"
+)
+
+// version identifies the index key pipeline. It is incremented whenever a release may change the key of any word,
+// so that an index built with one version is never queried with keys of another.
+const version = "5"
+
+// keyStemmer returns the stemmer of the pipeline, built on first use with the default configuration. It is private
+// to the package, so that setters called on stemmer.Default() don't change the keys.
+var keyStemmer = sync.OnceValue(stemmer.NewArabicLightStemmer)
+
+// IndexKey returns the key under which the given word should be stored in, and looked up from, an inverted index.
+// The pipeline is fixed: tashkeel and tatweel are removed, the word is light stemmed with the default configuration,
+// and the stem is normalized for search (hamza seats, TEH MARBUTA and ALEF MAKSURA). The pipeline has its own stemmer:
+// setters called on other stemmers, including stemmer.Default(), have no effect on it, so keys only change when
+// Version changes.
+func IndexKey(word string) string {
+	word = normalize.StripTatweel(normalize.StripTashkeel(word))
+	if word == "" {
+		return ""
+	}
+	return normalize.SearchText(keyStemmer().LightStem(word))
+}
+
+// Version returns the version of the index key pipeline. Store it alongside an index, and rebuild the index
+// when a library upgrade reports a different version.
+func Version() string {
+	return version
+}
@@ -0,0 +1,101 @@
+package keywords
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Keyword is a stem extracted from a text, with the surface form that represents it.
+type Keyword struct {
+	// Stem is the normalized light stem shared by the occurrences of the keyword.
+	Stem string
+	// Word is the most frequent unvocalized surface form of the stem in the text.
+	Word string
+	// Count is the number of occurrences of the stem.
+	Count int
+	// Score ranks the keyword; higher is more relevant.
+	Score float64
+}
+
+type KeywordExtractor interface {
+	ExtractKeywords(text string, n int) []Keyword
+}
+
+// keywordExtractor scores the stems of a text by frequency and position.
+type keywordExtractor struct {
+	stemmer *stemmer.ArabicLightStemmer
+}
+
+// NewKeywordExtractor creates a new instance of KeywordExtractor using the given stemmer.
+func NewKeywordExtractor(als *stemmer.ArabicLightStemmer) KeywordExtractor {
+	return &keywordExtractor{stemmer: als}
+}
+
+// ExtractKeywords returns the n best keywords of the text, best first. A value of n of zero or less returns all keywords.
+// Stopwords, numbers and single letters are ignored. Each occurrence of a stem adds between 1 and 2 to its score,
+// earlier occurrences weighing more, since the topic of a text is usually stated at its beginning.
+func (ke *keywordExtractor) ExtractKeywords(text string, n int) []Keyword {
+	tokens := ke.stemmer.Tokenize(text)
+	stopwords := ke.stemmer.StopwordMask(tokens)
+	type candidate struct {
+		keyword Keyword
+		forms   map[string]int
+		order   int
+	}
+	candidates := make(map[string]*candidate)
+
+	for position, token := range tokens {
+		if !isWord(token) || stopwords[position] {
+			continue
+		}
+		stem := ke.stemmer.NormalizeSearchText(ke.stemmer.LightStem(token))
+		if utf8.RuneCountInString(stem) < 2 {
+			continue
+		}
+		c, exists := candidates[stem]
+		if !exists {
+			c = &candidate{keyword: Keyword{Stem: stem}, forms: make(map[string]int), order: len(candidates)}
+			candidates[stem] = c
+		}
+		c.keyword.Count++
+		c.keyword.Score += 2 - float64(position)/float64(len(tokens))
+		form := normalize.StripTashkeel(token)
+		c.forms[form]++
+		if c.keyword.Word == "" || c.forms[form] > c.forms[c.keyword.Word] {
+			c.keyword.Word = form
+		}
+	}
+
+	sorted := make([]*candidate, 0, len(candidates))
+	for _, c := range candidates {
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].keyword.Score != sorted[j].keyword.Score {
+			return sorted[i].keyword.Score > sorted[j].keyword.Score
+		}
+		return sorted[i].order < sorted[j].order
+	})
+	if n > 0 && len(sorted) > n {
+		sorted = sorted[:n]
+	}
+
+	keywords := make([]Keyword, len(sorted))
+	for i, c := range sorted {
+		keywords[i] = c.keyword
+	}
+	return keywords
+}
+
+// isWord checks if the token contains at least one letter, which rules out numbers.
+func isWord(token string) bool {
+	for _, char := range token {
+		if unicode.IsLetter(char) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package light10ext
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+	"unicode/utf8"
+)
+
+// prefixes are the conjunction, preposition and article combinations removed by the rule set, longest first.
+// At most one is removed, and only if at least two letters remain.
+var prefixes = []string{
+	constant.WAW + constant.BEH + constant.ALEF + constant.LAM,
+	constant.FEH + constant.BEH + constant.ALEF + constant.LAM,
+	constant.WAW + constant.KAF + constant.ALEF + constant.LAM,
+	constant.FEH + constant.KAF + constant.ALEF + constant.LAM,
+	constant.WAW + constant.ALEF + constant.LAM,
+	constant.FEH + constant.ALEF + constant.LAM,
+	constant.BEH + constant.ALEF + constant.LAM,
+	constant.KAF + constant.ALEF + constant.LAM,
+	constant.WAW + constant.LAM + constant.LAM,
+	constant.FEH + constant.LAM + constant.LAM,
+	constant.ALEF + constant.LAM,
+	constant.LAM + constant.LAM,
+}
+
+// conjunctions are removed from words of four letters or more that had no other prefix.
+var conjunctions = []string{constant.WAW, constant.FEH}
+
+// suffixes are the pronoun, plural and feminine suffixes removed by the rule set, longest first. They are removed
+// repeatedly, each time the longest one leaving at least three letters.
+var suffixes = []string{
+	constant.HEH + constant.MEEM + constant.ALEF,
+	constant.KAF + constant.MEEM + constant.ALEF,
+	constant.HEH + constant.ALEF,
+	constant.HEH + constant.MEEM,
+	constant.HEH + constant.NOON,
+	constant.KAF + constant.MEEM,
+	constant.NOON + constant.ALEF,
+	constant.ALEF + constant.NOON,
+	constant.ALEF + constant.TEH,
+	constant.WAW + constant.NOON,
+	constant.YEH + constant.NOON,
+	constant.YEH + constant.HEH,
+	constant.HEH,
+	constant.YEH,
+}
+
+var normalizer = strings.NewReplacer(
+	constant.ALEF_MADDA, constant.ALEF,
+	constant.ALEF_HAMZA_ABOVE, constant.ALEF,
+	constant.ALEF_HAMZA_BELOW, constant.ALEF,
+	constant.ALEF_MAKSURA, constant.YEH,
+	constant.TEH_MARBUTA, constant.HEH,
+	constant.TATWEEL, "",
+	constant.FATHATAN, "",
+	constant.DAMMATAN, "",
+	constant.KASRATAN, "",
+	constant.FATHA, "",
+	constant.DAMMA, "",
+	constant.KASRA, "",
+	constant.SHADDA, "",
+	constant.SUKUN, "",
+)
+
+// minStem is the number of letters a suffix removal must leave.
+const minStem = 3
+
+// Normalize applies the normalization of the rule set, that of Light10: alef variants become ALEF, ALEF MAKSURA
+// becomes YEH, TEH MARBUTA becomes HEH, and tatweel and tashkeel are removed.
+func Normalize(word string) string {
+	return normalizer.Replace(word)
+}
+
+// Stem applies the extended Light10 rules to a word. They add to Light10 the combinations of a conjunction
+// with a preposition and the article, and the pronouns of the plural and dual, and remove suffixes repeatedly
+// rather than once each, so that ومعلماتهم and معلمات share the stem معلم.
+func Stem(word string) string {
+	word = Normalize(word)
+	stripped := false
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(word, prefix) && utf8.RuneCountInString(word) >= utf8.RuneCountInString(prefix)+2 {
+			word, stripped = strings.TrimPrefix(word, prefix), true
+			break
+		}
+	}
+	if !stripped && utf8.RuneCountInString(word) >= 4 {
+		for _, conjunction := range conjunctions {
+			if strings.HasPrefix(word, conjunction) {
+				word = strings.TrimPrefix(word, conjunction)
+				break
+			}
+		}
+	}
+	for removed := true; removed; {
+		removed = false
+		for _, suffix := range suffixes {
+			if strings.HasSuffix(word, suffix) && utf8.RuneCountInString(word) >= utf8.RuneCountInString(suffix)+minStem {
+				word, removed = strings.TrimSuffix(word, suffix), true
+				break
+			}
+		}
+	}
+	return word
+}
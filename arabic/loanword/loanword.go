@@ -0,0 +1,95 @@
+package loanword
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/chars"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+)
+
+const (
+	knownWeight    = 1.0
+	foreignWeight  = 1.0
+	morphemeWeight = 0.6
+	vowelWeight    = 0.3
+	minVowelLength = 6
+	minVowelRatio  = 0.4
+)
+
+type LoanwordDetector interface {
+	IsLoanword(word string) bool
+	Score(word string) float64
+}
+
+// loanwordDetector detects transliterated foreign words with a small set of weighted rules.
+type loanwordDetector struct {
+	known     map[string]bool
+	morphemes []string
+	threshold float64
+}
+
+// NewLoanwordDetector creates a new instance of LoanwordDetector with the provided known loanwords,
+// foreign morphemes and decision threshold.
+func NewLoanwordDetector(known []string, morphemes []string, threshold float64) LoanwordDetector {
+	ld := &loanwordDetector{
+		known:     make(map[string]bool, len(known)),
+		morphemes: morphemes,
+		threshold: threshold,
+	}
+	for _, word := range known {
+		ld.known[word] = true
+	}
+	return ld
+}
+
+// IsLoanword checks if the given unvocalized word is likely a transliterated foreign word.
+// It returns true if the word's score reaches the detector's threshold.
+func (ld *loanwordDetector) IsLoanword(word string) bool {
+	return ld.Score(word) >= ld.threshold
+}
+
+// Score returns a heuristic score for the given unvocalized word, higher meaning more likely foreign.
+// The score adds weights for membership in the known loanwords list, letters used only in transliteration,
+// typical foreign morphemes, and a high proportion of long vowels in longer words. A morpheme alone stays below
+// the default threshold. Morphemes aren't counted in imperfect verbs in the plural, e.g. يعرفون, whose ending
+// matches فون. A leading definite article is ignored.
+func (ld *loanwordDetector) Score(word string) float64 {
+	word = strings.TrimPrefix(word, constant.ALEF+constant.LAM)
+	if word == "" {
+		return 0
+	}
+
+	score := 0.0
+	if ld.known[word] {
+		score += knownWeight
+	}
+	if chars.ContainsAny(word, chars.ForeignLetters) {
+		score += foreignWeight
+	}
+	for _, morpheme := range ld.morphemes {
+		if strings.Contains(word, morpheme) && !imperfectPlural(word) {
+			score += morphemeWeight
+			break
+		}
+	}
+
+	runeWord := []rune(word)
+	if len(runeWord) >= minVowelLength {
+		vowels := 0
+		for _, char := range runeWord {
+			if strings.ContainsRune(constant.ALEF+constant.WAW+constant.YEH, char) {
+				vowels++
+			}
+		}
+		if float64(vowels)/float64(len(runeWord)) >= minVowelRatio {
+			score += vowelWeight
+		}
+	}
+	return score
+}
+
+// imperfectPlural reports whether the word has the shape of an imperfect verb in the plural, starting with
+// YEH, TEH or NOON and ending in ون or ين.
+func imperfectPlural(word string) bool {
+	return strings.ContainsAny(string([]rune(word)[0]), constant.YEH+constant.TEH+constant.NOON) &&
+		(strings.HasSuffix(word, constant.WAW+constant.NOON) || strings.HasSuffix(word, constant.YEH+constant.NOON))
+}
@@ -0,0 +1,30 @@
+package loanword
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"testing"
+)
+
+func TestIsLoanword(t *testing.T) {
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"تلفزيون", true},
+		{"الكمبيوتر", true},
+		{"بيولوجيا", true},
+		{"پيتزا", true},
+		{"يعرفون", false},
+		{"يخافون", false},
+		{"المستضعفون", false},
+		{"الإجرام", false},
+		{"كتاب", false},
+		{"المدرسة", false},
+	}
+	ld := NewLoanwordDetector(constant.LOANWORDS, constant.LOANWORD_MORPHEMES, constant.DEFAULT_LOANWORD_THRESHOLD)
+	for _, tt := range tests {
+		if got := ld.IsLoanword(tt.word); got != tt.want {
+			t.Errorf("IsLoanword(%q) = %v, want %v (score %.1f)", tt.word, got, tt.want, ld.Score(tt.word))
+		}
+	}
+}
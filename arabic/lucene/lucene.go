@@ -0,0 +1,93 @@
+package lucene
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+	"unicode/utf8"
+)
+
+// prefixes are the prefixes removed by Lucene's ArabicStemmer, in the order they are tried. At most one is removed.
+var prefixes = []string{
+	constant.ALEF + constant.LAM,
+	constant.WAW + constant.ALEF + constant.LAM,
+	constant.BEH + constant.ALEF + constant.LAM,
+	constant.KAF + constant.ALEF + constant.LAM,
+	constant.FEH + constant.ALEF + constant.LAM,
+	constant.LAM + constant.LAM,
+	constant.WAW,
+}
+
+// suffixes are the suffixes removed by Lucene's ArabicStemmer, in the order they are tried. Each may be removed once.
+var suffixes = []string{
+	constant.HEH + constant.ALEF,
+	constant.ALEF + constant.NOON,
+	constant.ALEF + constant.TEH,
+	constant.WAW + constant.NOON,
+	constant.YEH + constant.NOON,
+	constant.YEH + constant.HEH,
+	constant.YEH + constant.TEH_MARBUTA,
+	constant.HEH,
+	constant.TEH_MARBUTA,
+	constant.YEH,
+}
+
+var normalizer = strings.NewReplacer(
+	constant.ALEF_MADDA, constant.ALEF,
+	constant.ALEF_HAMZA_ABOVE, constant.ALEF,
+	constant.ALEF_HAMZA_BELOW, constant.ALEF,
+	constant.ALEF_MAKSURA, constant.YEH,
+	constant.TEH_MARBUTA, constant.HEH,
+	constant.TATWEEL, "",
+	constant.FATHATAN, "",
+	constant.DAMMATAN, "",
+	constant.KASRATAN, "",
+	constant.FATHA, "",
+	constant.DAMMA, "",
+	constant.KASRA, "",
+	constant.SHADDA, "",
+	constant.SUKUN, "",
+)
+
+// Normalize applies the normalization of Lucene's ArabicNormalizationFilter: alef variants become ALEF,
+// ALEF MAKSURA becomes YEH, TEH MARBUTA becomes HEH, and tatweel and tashkeel are removed.
+func Normalize(word string) string {
+	return normalizer.Replace(word)
+}
+
+// Stem applies the light stemming of Lucene's ArabicStemFilter (Larkey's Light-10) to a normalized word.
+// A prefix is removed only if at least two letters remain, and the single-letter WAW prefix only from words
+// of four letters or more. Suffixes are then removed in turn, each only if at least two letters remain.
+func Stem(word string) string {
+	for _, prefix := range prefixes {
+		if startsWithCheckLength(word, prefix) {
+			word = strings.TrimPrefix(word, prefix)
+			break
+		}
+	}
+	for _, suffix := range suffixes {
+		if endsWithCheckLength(word, suffix) {
+			word = strings.TrimSuffix(word, suffix)
+		}
+	}
+	return word
+}
+
+// startsWithCheckLength checks if the word starts with the prefix and is long enough for the prefix to be removed.
+func startsWithCheckLength(word, prefix string) bool {
+	wordLength, prefixLength := utf8.RuneCountInString(word), utf8.RuneCountInString(prefix)
+	if prefixLength == 1 && wordLength < 4 {
+		return false
+	}
+	if wordLength < prefixLength+2 {
+		return false
+	}
+	return strings.HasPrefix(word, prefix)
+}
+
+// endsWithCheckLength checks if the word ends with the suffix and is long enough for the suffix to be removed.
+func endsWithCheckLength(word, suffix string) bool {
+	if utf8.RuneCountInString(word) < utf8.RuneCountInString(suffix)+2 {
+		return false
+	}
+	return strings.HasSuffix(word, suffix)
+}
@@ -0,0 +1,140 @@
+package ngram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultOrder is the length of the longest n-grams counted by a model created with an order of zero.
+	DefaultOrder = 3
+	// boundary pads the words, so that their first and last letters are scored in context.
+	boundary = ' '
+	// backoff discounts the score of a shorter n-gram used when a longer one wasn't seen.
+	backoff = 0.4
+)
+
+// ErrInvalidModel is returned when a serialized model has no order or no n-grams.
+var ErrInvalidModel = errors.New("ngram: invalid model")
+
+// Model is a character-level language model scoring how plausible a word is,
+// e.g. to choose between candidate stems or roots. Train must not be called concurrently with other methods.
+type Model interface {
+	// Order returns the length of the longest n-grams counted by the model.
+	Order() int
+	// Train counts the n-grams of the word.
+	Train(word string)
+	// Score returns the mean log-probability of the letters of the word and of its end. Higher scores are more plausible.
+	Score(word string) float64
+	// WriteJSON serializes the model, so that it can be read back by LoadJSON.
+	WriteJSON(w io.Writer) error
+}
+
+// model is a stupid backoff model over the n-grams of the padded words.
+type model struct {
+	order int
+	// ngrams counts the n-grams of every length up to the order, and contexts counts them when followed by a letter.
+	ngrams   map[string]int
+	contexts map[string]int
+	// letters is the number of unigrams, and alphabet the number of distinct ones.
+	letters  int
+	alphabet int
+}
+
+// serializedModel is the JSON form of a model. Contexts are derived from the n-grams when loading.
+type serializedModel struct {
+	Order  int            `json:"order"`
+	NGrams map[string]int `json:"ngrams"`
+}
+
+// NewModel creates an empty model counting n-grams up to the given order, or DefaultOrder if it's zero or less.
+func NewModel(order int) Model {
+	if order <= 0 {
+		order = DefaultOrder
+	}
+	return &model{order: order, ngrams: make(map[string]int), contexts: make(map[string]int)}
+}
+
+// LoadJSON reads a model written by WriteJSON.
+func LoadJSON(r io.Reader) (Model, error) {
+	var serialized serializedModel
+	if err := json.NewDecoder(r).Decode(&serialized); err != nil {
+		return nil, err
+	}
+	if serialized.Order <= 0 || len(serialized.NGrams) == 0 {
+		return nil, fmt.Errorf("%w: order %d with %d n-grams", ErrInvalidModel, serialized.Order, len(serialized.NGrams))
+	}
+	m := &model{order: serialized.Order, ngrams: make(map[string]int), contexts: make(map[string]int)}
+	for ngram, count := range serialized.NGrams {
+		m.add(ngram, count)
+	}
+	return m, nil
+}
+
+func (m *model) Order() int {
+	return m.order
+}
+
+func (m *model) Train(word string) {
+	padded := m.pad(word)
+	for i := m.order - 1; i < len(padded); i++ {
+		for n := 1; n <= m.order; n++ {
+			m.add(string(padded[i-n+1:i+1]), 1)
+		}
+	}
+}
+
+// add counts an n-gram, along with its context and, for unigrams, the alphabet.
+func (m *model) add(ngram string, count int) {
+	if m.ngrams[ngram] == 0 && utf8.RuneCountInString(ngram) == 1 {
+		m.alphabet++
+	}
+	m.ngrams[ngram] += count
+	_, size := utf8.DecodeLastRuneInString(ngram)
+	if context := ngram[:len(ngram)-size]; context != "" {
+		m.contexts[context] += count
+	} else {
+		m.letters += count
+	}
+}
+
+func (m *model) Score(word string) float64 {
+	padded := m.pad(word)
+	total := 0.0
+	for i := m.order - 1; i < len(padded); i++ {
+		total += math.Log(m.probability(padded[i-m.order+1 : i+1]))
+	}
+	return total / float64(len(padded)-m.order+1)
+}
+
+// probability returns the stupid backoff score of the last letter of the n-gram given the letters before it.
+func (m *model) probability(ngram []rune) float64 {
+	discount := 1.0
+	for len(ngram) > 1 {
+		if count := m.ngrams[string(ngram)]; count > 0 {
+			return discount * float64(count) / float64(m.contexts[string(ngram[:len(ngram)-1])])
+		}
+		discount *= backoff
+		ngram = ngram[1:]
+	}
+	// Unseen letters keep a small probability through add-one smoothing
+	return discount * float64(m.ngrams[string(ngram)]+1) / float64(m.letters+m.alphabet+1)
+}
+
+// pad surrounds the word with boundaries, order-1 before it and one after it.
+func (m *model) pad(word string) []rune {
+	padded := make([]rune, 0, m.order+utf8.RuneCountInString(word))
+	for i := 1; i < m.order; i++ {
+		padded = append(padded, boundary)
+	}
+	padded = append(padded, []rune(word)...)
+	return append(padded, boundary)
+}
+
+func (m *model) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(serializedModel{Order: m.order, NGrams: m.ngrams})
+}
@@ -0,0 +1,89 @@
+package nisba
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/plural"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"strings"
+)
+
+// Nisba holds the result of nisba adjective analysis.
+type Nisba struct {
+	// Word is the analyzed word.
+	Word string
+	// Base is the noun the adjective is derived from, e.g. عراق for عراقية.
+	Base string
+	// Suffix is the nisba ending that was identified, including any number or gender marker.
+	Suffix string
+	Gender plural.Gender
+	Number plural.Number
+}
+
+type NisbaAnalyzer interface {
+	Analyze(word string) (Nisba, bool)
+}
+
+// nisbaAnalyzer recognizes nisba adjectives (عراقي، مصرية) and their inflected forms.
+type nisbaAnalyzer struct {
+	exceptions    map[string]bool
+	rootsManager  roots.RootsManager
+	minBaseLength int
+}
+
+// nisbaSuffix describes a nisba ending and the features it marks.
+type nisbaSuffix struct {
+	suffix string
+	gender plural.Gender
+	number plural.Number
+}
+
+// nisbaSuffixes lists the recognized endings, longest first so that inflected forms win over the bare ي.
+var nisbaSuffixes = []nisbaSuffix{
+	{"يتان", plural.GenderFeminine, plural.NumberDual},
+	{"يتين", plural.GenderFeminine, plural.NumberDual},
+	{"يات", plural.GenderFeminine, plural.NumberPlural},
+	{"يون", plural.GenderMasculine, plural.NumberPlural},
+	{"يين", plural.GenderMasculine, plural.NumberDualOrPlural},
+	{"يان", plural.GenderMasculine, plural.NumberDual},
+	{"ية", plural.GenderFeminine, plural.NumberSingular},
+	{"ي", plural.GenderMasculine, plural.NumberSingular},
+}
+
+// NewNisbaAnalyzer creates a new instance of NisbaAnalyzer with the provided exceptions and RootsManager.
+// The RootsManager is used to protect words whose final YEH is a root letter, such as مشي.
+func NewNisbaAnalyzer(exceptions []string, rootsManager roots.RootsManager, minBaseLength int) NisbaAnalyzer {
+	na := &nisbaAnalyzer{
+		exceptions:    make(map[string]bool, len(exceptions)),
+		rootsManager:  rootsManager,
+		minBaseLength: minBaseLength,
+	}
+	for _, word := range exceptions {
+		na.exceptions[word] = true
+	}
+	return na
+}
+
+// Analyze checks whether the given unvocalized word is a nisba adjective and returns its base noun and features.
+// Words listed as exceptions, words whose base would be shorter than the minimum length (not counting a definite
+// article), and words whose YEH-final form is itself a dictionary root are rejected.
+func (na *nisbaAnalyzer) Analyze(word string) (Nisba, bool) {
+	bare := strings.TrimPrefix(word, constant.ALEF+constant.LAM)
+	for _, ns := range nisbaSuffixes {
+		if !strings.HasSuffix(word, ns.suffix) {
+			continue
+		}
+		base := strings.TrimSuffix(word, ns.suffix)
+		bareBase := strings.TrimSuffix(bare, ns.suffix)
+		if len([]rune(bareBase)) < na.minBaseLength {
+			return Nisba{}, false
+		}
+		if na.exceptions[bare] || na.exceptions[bareBase+constant.YEH] {
+			return Nisba{}, false
+		}
+		if na.rootsManager.IsRoot(na.rootsManager.NormalizeRoot(bareBase + constant.YEH)) {
+			return Nisba{}, false
+		}
+		return Nisba{Word: word, Base: base, Suffix: ns.suffix, Gender: ns.gender, Number: ns.number}, true
+	}
+	return Nisba{}, false
+}
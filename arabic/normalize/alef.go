@@ -0,0 +1,42 @@
+package normalize
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+)
+
+// AlefTreatment controls how the alef variants of classical and Quranic orthography are normalized:
+// ALEF WASLA (ٱ) and the superscript, or dagger, alef (ٰ).
+type AlefTreatment int
+
+const (
+	// AlefToPlain replaces the character with a plain ALEF.
+	AlefToPlain AlefTreatment = iota
+	// AlefKeep leaves the character unchanged.
+	AlefKeep
+	// AlefStrip removes the character.
+	AlefStrip
+)
+
+// AlefWasla applies the treatment to every ALEF WASLA of the text, e.g. ٱلكتاب → الكتاب with AlefToPlain.
+func AlefWasla(text string, treatment AlefTreatment) string {
+	return normalizeAlef(text, constant.ALEF_WASLA, treatment)
+}
+
+// DaggerAlef applies the treatment to every superscript alef of the text,
+// e.g. هٰذا → هذا with AlefStrip, or هاذا with AlefToPlain.
+func DaggerAlef(text string, treatment AlefTreatment) string {
+	return normalizeAlef(text, constant.MINI_ALEF, treatment)
+}
+
+// normalizeAlef replaces or removes the alef variant according to the treatment.
+func normalizeAlef(text, variant string, treatment AlefTreatment) string {
+	switch treatment {
+	case AlefToPlain:
+		return strings.ReplaceAll(text, variant, constant.ALEF)
+	case AlefStrip:
+		return strings.ReplaceAll(text, variant, "")
+	default:
+		return text
+	}
+}
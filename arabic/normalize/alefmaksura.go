@@ -0,0 +1,57 @@
+package normalize
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/chars"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/regex"
+	"strings"
+	"unicode"
+)
+
+// AlefMaksuraPolicy controls the mapping between ALEF MAKSURA (ى) and YEH (ي), which conflates distinct words
+// such as على and علي.
+type AlefMaksuraPolicy int
+
+const (
+	// AlefMaksuraToYeh replaces every ALEF MAKSURA with a YEH, e.g. على → علي.
+	AlefMaksuraToYeh AlefMaksuraPolicy = iota
+	// AlefMaksuraKeep leaves both letters unchanged.
+	AlefMaksuraKeep
+	// YehToAlefMaksura replaces every word-final YEH with an ALEF MAKSURA, e.g. علي → على,
+	// following the orthography where the two aren't distinguished at the end of words.
+	YehToAlefMaksura
+)
+
+// AlefMaksura applies the policy to the text.
+func AlefMaksura(text string, policy AlefMaksuraPolicy) string {
+	switch policy {
+	case AlefMaksuraToYeh:
+		return regex.AlefMaksura().ReplaceAllString(text, constant.YEH)
+	case YehToAlefMaksura:
+		return finalYehToAlefMaksura(text)
+	default:
+		return text
+	}
+}
+
+// finalYehToAlefMaksura replaces the YEH ending a word, possibly followed by tashkeel, with an ALEF MAKSURA.
+func finalYehToAlefMaksura(text string) string {
+	if !strings.Contains(text, constant.YEH) {
+		return text
+	}
+	runes := []rune(text)
+	yeh, alefMaksura := []rune(constant.YEH)[0], []rune(constant.ALEF_MAKSURA)[0]
+	for i, char := range runes {
+		if char != yeh {
+			continue
+		}
+		next := i + 1
+		for next < len(runes) && chars.IsTashkeel(runes[next]) {
+			next++
+		}
+		if next == len(runes) || !unicode.IsLetter(runes[next]) {
+			runes[i] = alefMaksura
+		}
+	}
+	return string(runes)
+}
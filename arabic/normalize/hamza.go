@@ -0,0 +1,36 @@
+package normalize
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/regex"
+	"strings"
+)
+
+// HamzaLevel controls how far the hamza forms are normalized, from keeping every seat to writing every hamza
+// as a bare HAMZA (ء).
+type HamzaLevel int
+
+const (
+	// HamzaNone leaves the hamza forms unchanged.
+	HamzaNone HamzaLevel = iota
+	// HamzaSeats removes the hamza from its seat: the ALEF forms and the combining hamzas become a bare ALEF,
+	// and the WAW and YEH carrying a hamza become a HAMZA, e.g. أسئلة → اسءلة. It's the level of search text.
+	HamzaSeats
+	// HamzaFull writes every hamza as a bare HAMZA and ALEF MADDA as HAMZA followed by ALEF, e.g. أسئلة → ءسءلة.
+	// It's the level of the roots dictionary and verb stamps.
+	HamzaFull
+)
+
+// Hamza normalizes the hamza forms of the text to the given level.
+func Hamza(text string, level HamzaLevel) string {
+	switch level {
+	case HamzaSeats:
+		text = regex.Alefat().ReplaceAllString(text, constant.ALEF)
+		return regex.Hamzat().ReplaceAllString(text, constant.HAMZA)
+	case HamzaFull:
+		text = strings.ReplaceAll(text, constant.ALEF_MADDA, constant.HAMZA+constant.ALEF)
+		return CollapseHamza(text)
+	default:
+		return text
+	}
+}
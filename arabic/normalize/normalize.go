@@ -0,0 +1,174 @@
+package normalize
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/chars"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/regex"
+	"strings"
+)
+
+// Options selects the normalization steps applied by Text. The zero value only writes ALEF WASLA as ALEF,
+// TEH MARBUTA as nothing and ALEF MAKSURA as YEH, so options are best derived from one of the presets.
+type Options struct {
+	// StripInvisible removes bidirectional controls, zero-width characters and byte order marks.
+	StripInvisible bool `json:"strip_invisible" yaml:"strip_invisible"`
+	// StripTashkeel removes the harakat, tanwin, shadda and sukun.
+	StripTashkeel bool `json:"strip_tashkeel" yaml:"strip_tashkeel"`
+	// StripTatweel removes the kashidas.
+	StripTatweel bool `json:"strip_tatweel" yaml:"strip_tatweel"`
+	// ExpandLamAlef replaces the LAM ALEF ligatures with their two letters.
+	ExpandLamAlef bool              `json:"expand_lam_alef" yaml:"expand_lam_alef"`
+	AlefWasla     AlefTreatment     `json:"alef_wasla" yaml:"alef_wasla"`
+	DaggerAlef    AlefTreatment     `json:"dagger_alef" yaml:"dagger_alef"`
+	Hamza         HamzaLevel        `json:"hamza" yaml:"hamza"`
+	TehMarbuta    TehMarbutaPolicy  `json:"teh_marbuta" yaml:"teh_marbuta"`
+	AlefMaksura   AlefMaksuraPolicy `json:"alef_maksura" yaml:"alef_maksura"`
+}
+
+// SearchOptions returns the options of SearchText: diacritics, kashidas and invisible characters are removed,
+// the alef variants, ligatures and hamza seats are unified, TEH MARBUTA becomes HEH and ALEF MAKSURA becomes YEH.
+func SearchOptions() Options {
+	return Options{
+		StripInvisible: true,
+		StripTashkeel:  true,
+		StripTatweel:   true,
+		ExpandLamAlef:  true,
+		AlefWasla:      AlefToPlain,
+		DaggerAlef:     AlefStrip,
+		Hamza:          HamzaSeats,
+		TehMarbuta:     TehMarbutaToHeh,
+		AlefMaksura:    AlefMaksuraToYeh,
+	}
+}
+
+// LetterOptions returns the options applied by the stemmer to words before segmentation. They only change
+// characters one at a time, leaving the tashkeel, hamzas, TEH MARBUTA and ALEF MAKSURA in place.
+func LetterOptions() Options {
+	return Options{
+		StripInvisible: true,
+		StripTatweel:   true,
+		ExpandLamAlef:  true,
+		AlefWasla:      AlefToPlain,
+		DaggerAlef:     AlefStrip,
+		Hamza:          HamzaNone,
+		TehMarbuta:     TehMarbutaKeep,
+		AlefMaksura:    AlefMaksuraKeep,
+	}
+}
+
+// RootOptions returns the options bringing a root into the form of the roots dictionary:
+// every hamza is a bare HAMZA, TEH MARBUTA is removed and ALEF MAKSURA becomes YEH.
+func RootOptions() Options {
+	return Options{
+		AlefWasla:   AlefKeep,
+		DaggerAlef:  AlefKeep,
+		Hamza:       HamzaFull,
+		TehMarbuta:  TehMarbutaStrip,
+		AlefMaksura: AlefMaksuraToYeh,
+	}
+}
+
+// Text applies the selected steps to the text, always in the same order: invisible characters, tashkeel and
+// kashidas are removed first, then the alef variants, ligatures, hamzas, TEH MARBUTA and ALEF MAKSURA are normalized.
+func Text(text string, options Options) string {
+	if options.StripInvisible {
+		text = StripInvisible(text)
+	}
+	if options.StripTashkeel {
+		text = StripTashkeel(text)
+	}
+	if options.StripTatweel {
+		text = StripTatweel(text)
+	}
+	text = AlefWasla(text, options.AlefWasla)
+	text = DaggerAlef(text, options.DaggerAlef)
+	if options.ExpandLamAlef {
+		text = LamAlef(text)
+	}
+	text = Hamza(text, options.Hamza)
+	text = TehMarbuta(text, options.TehMarbuta)
+	return AlefMaksura(text, options.AlefMaksura)
+}
+
+// SearchText brings the text into a canonical form for search, removing diacritics and invisible characters
+// and unifying the alef, hamza, teh marbuta and alef maksura variants.
+func SearchText(text string) string {
+	return Text(text, SearchOptions())
+}
+
+// SpellErrors writes TEH MARBUTA as HEH and ALEF MAKSURA as YEH, the most common spelling variations.
+func SpellErrors(text string) string {
+	return AlefMaksura(TehMarbuta(text, TehMarbutaToHeh), AlefMaksuraToYeh)
+}
+
+// StripTashkeel removes the tashkeel marks (harakat, tanwin, shadda and sukun) from the text.
+// Text without any mark is returned as is, without allocating.
+func StripTashkeel(text string) string {
+	if strings.IndexFunc(text, IsTashkeel) < 0 {
+		return text
+	}
+	return strings.Map(dropTashkeel, text)
+}
+
+// IsTashkeel reports whether the character is a tashkeel mark.
+func IsTashkeel(char rune) bool {
+	return chars.IsTashkeel(char)
+}
+
+// dropTashkeel is the strings.Map mapping removing tashkeel marks.
+func dropTashkeel(char rune) rune {
+	if IsTashkeel(char) {
+		return -1
+	}
+	return char
+}
+
+// StripInvisible removes the bidirectional controls (such as RLM and LRM), zero-width characters (ZWJ, ZWNJ)
+// and byte order marks from the text. They are invisible but would otherwise break affix and dictionary lookups.
+func StripInvisible(text string) string {
+	if strings.IndexFunc(text, chars.IsInvisible) < 0 {
+		return text
+	}
+	return strings.Map(dropInvisible, text)
+}
+
+// dropInvisible is the strings.Map mapping removing invisible characters.
+func dropInvisible(char rune) rune {
+	if chars.IsInvisible(char) {
+		return -1
+	}
+	return char
+}
+
+// StripTatweel removes the TATWEEL, or kashida, used to elongate words.
+func StripTatweel(text string) string {
+	return regex.Tatweel().ReplaceAllString(text, "")
+}
+
+// CollapseHamza writes every hamza, whatever its seat, as a bare HAMZA, which is the form used by the roots dictionary.
+// Unlike Hamza with HamzaFull, it leaves ALEF MADDA unchanged, so that each character maps to a single one.
+func CollapseHamza(text string) string {
+	return hamzaCollapser.Replace(text)
+}
+
+var hamzaCollapser = strings.NewReplacer(
+	constant.ALEF_HAMZA_ABOVE, constant.HAMZA,
+	constant.ALEF_HAMZA_BELOW, constant.HAMZA,
+	constant.WAW_HAMZA, constant.HAMZA,
+	constant.YEH_HAMZA, constant.HAMZA,
+	constant.HAMZA_ABOVE, constant.HAMZA,
+	constant.HAMZA_BELOW, constant.HAMZA,
+)
+
+// LamAlef expands the LAM ALEF presentation-form ligatures into their two-letter sequences,
+// keeping the hamza or madda carried by the alef.
+func LamAlef(text string) string {
+	return lamAlefReplacer.Replace(text)
+}
+
+var lamAlefReplacer = strings.NewReplacer(
+	constant.LAM_ALEF, constant.SIMPLE_LAM_ALEF,
+	constant.LAM_ALEF_HAMZA_ABOVE, constant.SIMPLE_LAM_ALEF_HAMZA_ABOVE,
+	constant.LAM_ALEF_HAMZA_BELOW, constant.SIMPLE_LAM_ALEF_HAMZA_BELOW,
+	constant.LAM_ALEF_MADDA_ABOVE, constant.SIMPLE_LAM_ALEF_MADDA_ABOVE,
+)
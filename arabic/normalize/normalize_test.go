@@ -0,0 +1,38 @@
+package normalize
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/regex"
+	"testing"
+)
+
+var benchmarkTexts = []struct {
+	name string
+	text string
+}{
+	{"vocalized", "ذَهَبَ الطُّلَّابُ إِلَى المَدْرَسَةِ وَالكُتَّابُ يَكْتُبُونَ فَسَيَكْتُبُونَهَا بِالمُعَلِّمِينَ"},
+	{"plain", "ذهب الطلاب إلى المدرسة والكتاب يكتبون فسيكتبونها بالمعلمين"},
+}
+
+// BenchmarkStripTashkeel compares StripTashkeel with the harakat regex it replaced.
+func BenchmarkStripTashkeel(b *testing.B) {
+	for _, bt := range benchmarkTexts {
+		b.Run(bt.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				StripTashkeel(bt.text)
+			}
+		})
+		b.Run(bt.name+"/regex", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				regex.Harakat().ReplaceAllString(bt.text, "")
+			}
+		})
+	}
+}
+
+func TestStripTashkeel(t *testing.T) {
+	for _, bt := range benchmarkTexts {
+		if got, want := StripTashkeel(bt.text), regex.Harakat().ReplaceAllString(bt.text, ""); got != want {
+			t.Errorf("StripTashkeel(%q) = %q, want %q", bt.text, got, want)
+		}
+	}
+}
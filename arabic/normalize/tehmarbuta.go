@@ -0,0 +1,31 @@
+package normalize
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/regex"
+)
+
+// TehMarbutaPolicy controls how the TEH MARBUTA (ة) is written in stems, roots and normalized search text.
+// Search stacks differ in their conventions, e.g. Lucene's Arabic normalizer writes it as HEH.
+type TehMarbutaPolicy int
+
+const (
+	// TehMarbutaStrip removes the character, e.g. مدرسة → مدرس.
+	TehMarbutaStrip TehMarbutaPolicy = iota
+	// TehMarbutaToHeh replaces the character with a HEH, e.g. مدرسة → مدرسه.
+	TehMarbutaToHeh
+	// TehMarbutaKeep leaves the character unchanged.
+	TehMarbutaKeep
+)
+
+// TehMarbuta applies the policy to every TEH MARBUTA of the text.
+func TehMarbuta(text string, policy TehMarbutaPolicy) string {
+	switch policy {
+	case TehMarbutaStrip:
+		return regex.TehMarbuta().ReplaceAllString(text, "")
+	case TehMarbutaToHeh:
+		return regex.TehMarbuta().ReplaceAllString(text, constant.HEH)
+	default:
+		return text
+	}
+}
@@ -0,0 +1,353 @@
+package numeral
+
+import (
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/tokenizer"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Kind is the kind of an expression.
+type Kind int
+
+const (
+	// Number is a number written with words, e.g. ثلاثة وعشرون, or with digits followed by a scale word, e.g. 5 آلاف.
+	Number Kind = iota
+	// Date is a date written with digits, e.g. 15/05/2023, or with a month name, e.g. 15 مايو 2023 or 3 رمضان 1445 هـ.
+	Date
+)
+
+// Expression is a number or a date found in a sequence of tokens.
+type Expression struct {
+	Kind Kind
+	// Start and End are the index of the first token of the expression and the index following its last token.
+	Start int
+	End   int
+	// Value is the normalized expression: a number in ASCII digits, or a date as YYYY-MM-DD, or YYYY-MM and --MM-DD
+	// when the day or the year is missing.
+	Value string
+	// Hijri is set for dates of the Hijri calendar, told by their month name or the هـ marker.
+	Hijri bool
+}
+
+// Find returns the numbers and dates of the tokens, as produced by the TokenizeTyped method of a TypedTokenizer,
+// in order and without overlap. A single word that is also a common noun or verb, such as ست or ألف, isn't taken
+// for a number on its own.
+func Find(tokens []tokenizer.Token) []Expression {
+	var expressions []Expression
+	for i := 0; i < len(tokens); {
+		expression, ok := findDate(tokens, i)
+		if !ok {
+			expression, ok = findNumber(tokens, i)
+		}
+		if !ok {
+			i++
+			continue
+		}
+		expressions = append(expressions, expression)
+		i = expression.End
+	}
+	return expressions
+}
+
+// ParseNumber parses the number written with words at the start of the words, e.g. خمسمائة وخمسة وعشرون.
+// It returns the number and the count of words it spans, zero if the words don't start with a number.
+// Every word may carry the conjunction و, e.g. وثلاث مئة.
+func ParseNumber(words []string) (int64, int) {
+	var total, current int64
+	var lastUnit int64
+	consumed := 0
+	ok := true
+	for i, word := range words {
+		key, conjunction := numberKey(word)
+		value, isNumber := numberWords[key]
+		scale, isScale := scaleWords[key]
+		switch {
+		case i == 0 && !isNumber && !isScale:
+			digits, isDigits := parseDigits(strings.TrimPrefix(word, constant.WAW))
+			if !isDigits || len(words) < 2 {
+				return 0, 0
+			}
+			// Digits only make a number expression when followed by a scale, e.g. 5 ملايين
+			next, _ := numberKey(words[1])
+			if _, isScale := scaleWords[next]; !isScale {
+				return 0, 0
+			}
+			current = digits
+		case isNumber && value == 10 && lastUnit > 0 && !conjunction && current < 100:
+			// أحد عشر, ثلاثة عشر
+			current += 10
+			lastUnit = 0
+		case isNumber && value == 100 && lastUnit >= 3 && !conjunction:
+			// ثلاث مئة
+			current += lastUnit*100 - lastUnit
+			lastUnit = 0
+		case isNumber:
+			current += value
+			lastUnit = 0
+			if value < 10 {
+				lastUnit = value
+			}
+		case isScale:
+			var term int64
+			switch {
+			case scale.count > 0:
+				term = current + scale.count*scale.value
+			case conjunction:
+				// A scale after و starts a new term, e.g. ثلاثة آلاف ومليون
+				term = current + scale.value
+			default:
+				if term, ok = multiply(max(current, 1), scale.value); !ok {
+					return 0, 0
+				}
+			}
+			if total, ok = add(total, term); !ok {
+				return 0, 0
+			}
+			current, lastUnit = 0, 0
+		default:
+			return sum(total, current, consumed)
+		}
+		consumed = i + 1
+	}
+	return sum(total, current, consumed)
+}
+
+// sum returns the number parsed by ParseNumber and the count of its words, or no number if it overflows an int64.
+func sum(total, current int64, consumed int) (int64, int) {
+	value, ok := add(total, current)
+	if !ok {
+		return 0, 0
+	}
+	return value, consumed
+}
+
+// add returns the sum of two non-negative numbers, or false if it overflows an int64.
+func add(a, b int64) (int64, bool) {
+	if a > math.MaxInt64-b {
+		return 0, false
+	}
+	return a + b, true
+}
+
+// multiply returns the product of two positive numbers, or false if it overflows an int64.
+func multiply(a, b int64) (int64, bool) {
+	if a > math.MaxInt64/b {
+		return 0, false
+	}
+	return a * b, true
+}
+
+// findNumber recognizes a number written with words starting at the token.
+func findNumber(tokens []tokenizer.Token, start int) (Expression, bool) {
+	var words []string
+	for i := start; i < len(tokens) && (tokens[i].Type == tokenizer.ArabicWord || tokens[i].Type == tokenizer.Number); i++ {
+		words = append(words, tokens[i].Text)
+	}
+	value, count := ParseNumber(words)
+	if count == 0 {
+		return Expression{}, false
+	}
+	if count == 1 {
+		if key, _ := numberKey(words[0]); ambiguousWords[key] {
+			return Expression{}, false
+		}
+	}
+	return Expression{Kind: Number, Start: start, End: start + count, Value: strconv.FormatInt(value, 10)}, true
+}
+
+// findDate recognizes a date starting at the token, written with digits or with a month name.
+func findDate(tokens []tokenizer.Token, start int) (Expression, bool) {
+	if expression, ok := findNumericDate(tokens, start); ok {
+		return expression, true
+	}
+	return findNamedDate(tokens, start)
+}
+
+// findNumericDate recognizes a date of three numbers separated by slashes or hyphens,
+// the year coming first or last, e.g. 2023-05-15 or 15/5/2023.
+func findNumericDate(tokens []tokenizer.Token, start int) (Expression, bool) {
+	if start+5 > len(tokens) {
+		return Expression{}, false
+	}
+	separator := tokens[start+1].Text
+	if (separator != "/" && separator != "-") || tokens[start+3].Text != separator {
+		return Expression{}, false
+	}
+	first, firstDigits, _, ok := parseNumberToken(tokens[start].Text, true)
+	if !ok {
+		return Expression{}, false
+	}
+	month, _, _, ok := parseNumberToken(tokens[start+2].Text, false)
+	if !ok {
+		return Expression{}, false
+	}
+	last, lastDigits, marker, ok := parseNumberToken(tokens[start+4].Text, false)
+	if !ok {
+		return Expression{}, false
+	}
+
+	var year, day int64
+	switch {
+	case firstDigits == 4 && marker == "":
+		year, day = first, last
+	case lastDigits == 4:
+		year, day = last, first
+	default:
+		return Expression{}, false
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return Expression{}, false
+	}
+	end := start + 5
+	if marker == "" {
+		marker, end = calendarMarker(tokens, end)
+	}
+	return Expression{
+		Kind:  Date,
+		Start: start,
+		End:   end,
+		Value: fmt.Sprintf("%04d-%02d-%02d", year, month, day),
+		Hijri: marker == hijriMarker,
+	}, true
+}
+
+// findNamedDate recognizes a date with a month name, an optional day before it and an optional year after it,
+// e.g. 15 مايو 2023, تشرين الأول 2020 or 3 رمضان. Either the day or the year is required.
+func findNamedDate(tokens []tokenizer.Token, start int) (Expression, bool) {
+	i := start
+	day, _, _, hasDay := parseNumberToken(tokens[i].Text, true)
+	if hasDay {
+		if day < 1 || day > 31 {
+			return Expression{}, false
+		}
+		i++
+	}
+	month, length, ok := matchMonth(tokens[i:])
+	if !ok {
+		return Expression{}, false
+	}
+	i += length
+
+	var year int64
+	var marker string
+	hasYear := false
+	if i < len(tokens) {
+		var digits int
+		if year, digits, marker, hasYear = parseNumberToken(tokens[i].Text, false); hasYear && digits >= 3 {
+			i++
+		} else {
+			hasYear = false
+		}
+	}
+	if !hasDay && !hasYear {
+		return Expression{}, false
+	}
+	if hasYear && marker == "" {
+		marker, i = calendarMarker(tokens, i)
+	}
+
+	var value string
+	switch {
+	case !hasYear:
+		value = fmt.Sprintf("--%02d-%02d", month.number, day)
+	case !hasDay:
+		value = fmt.Sprintf("%04d-%02d", year, month.number)
+	default:
+		value = fmt.Sprintf("%04d-%02d-%02d", year, month.number, day)
+	}
+	return Expression{Kind: Date, Start: start, End: i, Value: value, Hijri: month.hijri || marker == hijriMarker}, true
+}
+
+// matchMonth returns the month named by the first one or two tokens, and the number of tokens of its name.
+func matchMonth(tokens []tokenizer.Token) (month, int, bool) {
+	if len(tokens) == 0 || tokens[0].Type != tokenizer.ArabicWord {
+		return month{}, 0, false
+	}
+	first := normalize.SearchText(tokens[0].Text)
+	if len(tokens) > 1 && tokens[1].Type == tokenizer.ArabicWord {
+		if m, ok := months[first+" "+normalize.SearchText(tokens[1].Text)]; ok {
+			return m, 2, true
+		}
+	}
+	m, ok := months[first]
+	return m, 1, ok
+}
+
+// calendarMarker consumes the token following a year if it is the Hijri or Gregorian calendar marker.
+func calendarMarker(tokens []tokenizer.Token, i int) (string, int) {
+	if i < len(tokens) {
+		if marker := normalize.SearchText(tokens[i].Text); marker == hijriMarker || marker == gregorianMarker {
+			return marker, i + 1
+		}
+	}
+	return "", i
+}
+
+// parseNumberToken parses a token made of digits, in any script, that may be followed by a calendar marker,
+// e.g. 1445هـ, and preceded by the conjunction و if allowed. It returns the number, its count of digits and the marker.
+func parseNumberToken(text string, conjunction bool) (int64, int, string, bool) {
+	if conjunction {
+		text = strings.TrimPrefix(text, constant.WAW)
+	}
+	text = normalize.SearchText(text)
+	marker := ""
+	for _, m := range []string{hijriMarker, gregorianMarker} {
+		if trimmed := strings.TrimSuffix(text, m); trimmed != text {
+			text, marker = trimmed, m
+			break
+		}
+	}
+	value, ok := parseDigits(text)
+	return value, len([]rune(text)), marker, ok
+}
+
+// maxDigits is the longest run of digits parsed as a number, the most that always fits in an int64.
+const maxDigits = 18
+
+// parseDigits parses a run of Western, Arabic-Indic or Eastern Arabic-Indic digits, e.g. 2023, ٢٠٢٣ or ۲۰۲۳.
+// Runs longer than maxDigits aren't numbers.
+func parseDigits(text string) (int64, bool) {
+	if text == "" || utf8.RuneCountInString(text) > maxDigits {
+		return 0, false
+	}
+	var value int64
+	for _, char := range text {
+		var digit rune
+		switch {
+		case char >= '0' && char <= '9':
+			digit = char - '0'
+		case char >= '٠' && char <= '٩':
+			digit = char - '٠'
+		case char >= '۰' && char <= '۹':
+			digit = char - '۰'
+		default:
+			return 0, false
+		}
+		value = value*10 + int64(digit)
+	}
+	return value, true
+}
+
+// numberKey returns the normalized form of a word looked up in the number tables, without its conjunction
+// if the rest of the word is a number word and the whole word isn't, e.g. واحد. It reports whether a conjunction
+// was removed.
+func numberKey(word string) (string, bool) {
+	key := normalize.SearchText(word)
+	if _, ok := numberWords[key]; ok {
+		return key, false
+	}
+	if rest := strings.TrimPrefix(key, constant.WAW); rest != key {
+		if _, ok := numberWords[rest]; ok {
+			return rest, true
+		}
+		if _, ok := scaleWords[rest]; ok {
+			return rest, true
+		}
+	}
+	return key, false
+}
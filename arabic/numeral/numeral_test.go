@@ -0,0 +1,29 @@
+package numeral
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		words string
+		value int64
+		count int
+	}{
+		{"واحد", 1, 1},
+		{"واحد وعشرون", 21, 2},
+		{"ثلاثة آلاف وواحد", 3001, 3},
+		{"خمسمائة وخمسة وعشرون", 525, 3},
+		{"5 ملايين", 5000000, 2},
+		{"999999999999999999 مليار", 0, 0},
+		{"9999999999999999999 ألف", 0, 0},
+		{"٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩٩ ألف", 0, 0},
+	}
+	for _, tt := range tests {
+		value, count := ParseNumber(strings.Fields(tt.words))
+		if value != tt.value || count != tt.count {
+			t.Errorf("ParseNumber(%q) = %d, %d, want %d, %d", tt.words, value, count, tt.value, tt.count)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package numeral
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+)
+
+// Calendar markers following a year, in search form: هـ for the Hijri calendar and م for the Gregorian one.
+const (
+	hijriMarker     = "ه"
+	gregorianMarker = "م"
+)
+
+// scale is a word multiplying the number before it, or standing for a fixed count of its value, e.g. ألفان.
+type scale struct {
+	value int64
+	count int64
+}
+
+// month is a month name of the Gregorian, Levantine or Hijri calendars.
+type month struct {
+	number int
+	hijri  bool
+}
+
+var (
+	// numberWords maps the search form of the number words, from one to nine hundred, to their value.
+	numberWords = searchKeys(map[string]int64{
+		"واحد": 1, "واحدة": 1, "أحد": 1, "إحدى": 1,
+		"اثنان": 2, "اثنين": 2, "اثنتان": 2, "اثنتين": 2, "اثنا": 2, "اثنتا": 2,
+		"ثلاث": 3, "ثلاثة": 3, "أربع": 4, "أربعة": 4, "خمس": 5, "خمسة": 5, "ست": 6, "ستة": 6,
+		"سبع": 7, "سبعة": 7, "ثمان": 8, "ثماني": 8, "ثمانية": 8, "تسع": 9, "تسعة": 9, "عشر": 10, "عشرة": 10,
+		"عشرون": 20, "عشرين": 20, "ثلاثون": 30, "ثلاثين": 30, "أربعون": 40, "أربعين": 40,
+		"خمسون": 50, "خمسين": 50, "ستون": 60, "ستين": 60, "سبعون": 70, "سبعين": 70,
+		"ثمانون": 80, "ثمانين": 80, "تسعون": 90, "تسعين": 90,
+		"مئة": 100, "مائة": 100, "مئتان": 200, "مئتين": 200, "مائتان": 200, "مائتين": 200, "مئتا": 200, "مائتا": 200,
+		"ثلاثمئة": 300, "ثلاثمائة": 300, "أربعمئة": 400, "أربعمائة": 400, "خمسمئة": 500, "خمسمائة": 500,
+		"ستمئة": 600, "ستمائة": 600, "سبعمئة": 700, "سبعمائة": 700, "ثمانمئة": 800, "ثمانمائة": 800,
+		"ثمانيمئة": 800, "ثمانيمائة": 800, "تسعمئة": 900, "تسعمائة": 900,
+	})
+
+	// scaleWords maps the search form of the thousands, millions and billions, singular, dual and plural.
+	scaleWords = searchKeys(map[string]scale{
+		"ألف": {value: 1e3}, "ألفا": {value: 1e3}, "آلاف": {value: 1e3},
+		"ألفان": {value: 1e3, count: 2}, "ألفين": {value: 1e3, count: 2},
+		"مليون": {value: 1e6}, "مليونا": {value: 1e6}, "ملايين": {value: 1e6},
+		"مليونان": {value: 1e6, count: 2}, "مليونين": {value: 1e6, count: 2},
+		"مليار": {value: 1e9}, "مليارا": {value: 1e9}, "مليارات": {value: 1e9}, "بليون": {value: 1e9},
+		"ملياران": {value: 1e9, count: 2}, "مليارين": {value: 1e9, count: 2},
+	})
+
+	// ambiguousWords are number words that are also common nouns or verbs, e.g. ست (lady) or ألف (he wrote),
+	// or plural scales standing for an indefinite quantity, e.g. آلاف, only taken for numbers as part of a longer expression.
+	ambiguousWords = searchKeys(map[string]bool{
+		"أحد": true, "إحدى": true, "ست": true, "سبع": true, "خمس": true, "عشر": true, "تسع": true,
+		"ثمان": true, "ألف": true, "ألفا": true, "آلاف": true, "ملايين": true, "مليارات": true,
+	})
+
+	// months maps the search form of the month names, of one or two words, to their number and calendar.
+	months = searchKeys(map[string]month{
+		"يناير": {number: 1}, "فبراير": {number: 2}, "مارس": {number: 3}, "أبريل": {number: 4}, "إبريل": {number: 4},
+		"مايو": {number: 5}, "يونيو": {number: 6}, "يونيه": {number: 6}, "يوليو": {number: 7}, "يوليه": {number: 7},
+		"أغسطس": {number: 8}, "سبتمبر": {number: 9}, "أكتوبر": {number: 10}, "نوفمبر": {number: 11}, "ديسمبر": {number: 12},
+		"كانون الثاني": {number: 1}, "شباط": {number: 2}, "آذار": {number: 3}, "نيسان": {number: 4},
+		"أيار": {number: 5}, "حزيران": {number: 6}, "تموز": {number: 7}, "آب": {number: 8}, "أيلول": {number: 9},
+		"تشرين الأول": {number: 10}, "تشرين الثاني": {number: 11}, "كانون الأول": {number: 12},
+		"محرم": {number: 1, hijri: true}, "صفر": {number: 2, hijri: true},
+		"ربيع الأول": {number: 3, hijri: true}, "ربيع الثاني": {number: 4, hijri: true}, "ربيع الآخر": {number: 4, hijri: true},
+		"جمادى الأولى": {number: 5, hijri: true}, "جمادى الآخرة": {number: 6, hijri: true}, "جمادى الثانية": {number: 6, hijri: true},
+		"رجب": {number: 7, hijri: true}, "شعبان": {number: 8, hijri: true}, "رمضان": {number: 9, hijri: true},
+		"شوال": {number: 10, hijri: true}, "ذو القعدة": {number: 11, hijri: true}, "ذي القعدة": {number: 11, hijri: true},
+		"ذو الحجة": {number: 12, hijri: true}, "ذي الحجة": {number: 12, hijri: true},
+	})
+)
+
+// searchKeys returns the table keyed by the search form of its words, so that lookups ignore spelling variants.
+func searchKeys[V any](table map[string]V) map[string]V {
+	keyed := make(map[string]V, len(table))
+	for word, value := range table {
+		keyed[normalize.SearchText(word)] = value
+	}
+	return keyed
+}
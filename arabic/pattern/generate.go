@@ -0,0 +1,46 @@
+package pattern
+
+import (
+	"errors"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+)
+
+// ErrEmptyTemplate is returned when generation is requested with an empty template.
+var ErrEmptyTemplate = errors.New("pattern: empty template")
+
+// ErrSlotMismatch is returned when the number of root letters doesn't match the template's radical slots.
+var ErrSlotMismatch = errors.New("pattern: root length does not match template slots")
+
+// Generate instantiates a root into a morphological template, the inverse of root extraction.
+// Each FEH, AIN and LAM placeholder of the template is replaced, in order, by the next letter of the root,
+// so كتب with مفعول yields مكتوب. Quadriliteral templates (e.g. فعلل) require four root letters.
+func Generate(root, template string) (string, error) {
+	if template == "" {
+		return "", ErrEmptyTemplate
+	}
+	runeRoot := []rune(root)
+	runeTemplate := []rune(template)
+
+	slots := 0
+	for _, char := range runeTemplate {
+		if constant.PATTERN_SLOTS[char] {
+			slots++
+		}
+	}
+	if slots != len(runeRoot) {
+		return "", fmt.Errorf("%w: root %q has %d letters, template %q has %d slots", ErrSlotMismatch, root, len(runeRoot), template, slots)
+	}
+
+	result := make([]rune, len(runeTemplate))
+	next := 0
+	for i, char := range runeTemplate {
+		if constant.PATTERN_SLOTS[char] {
+			result[i] = runeRoot[next]
+			next++
+			continue
+		}
+		result[i] = char
+	}
+	return string(result), nil
+}
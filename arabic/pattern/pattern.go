@@ -0,0 +1,95 @@
+package pattern
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"sort"
+)
+
+// Pattern describes a morphological template (wazn) matched against a stem.
+type Pattern struct {
+	// Name is the template itself, written with the FEH/AIN/LAM placeholders (e.g. "مفعول").
+	Name string
+	// Stem is the stem the template was matched against.
+	Stem string
+	// Root is the sequence of letters filling the radical slots of the template.
+	Root string
+	// Slots holds the rune positions in Stem occupied by the root letters.
+	Slots []int
+}
+
+type PatternMatcher interface {
+	Match(stem string) []Pattern
+	Templates() []string
+}
+
+// patternMatcher matches stems against a list of morphological templates.
+type patternMatcher struct {
+	templates []string
+}
+
+// NewPatternMatcher creates a new instance of PatternMatcher with the provided templates.
+// Duplicate templates are ignored, and templates are kept in the order they were given.
+func NewPatternMatcher(templates []string) PatternMatcher {
+	pm := &patternMatcher{}
+	seen := make(map[string]bool)
+	for _, template := range templates {
+		if template == "" || seen[template] {
+			continue
+		}
+		seen[template] = true
+		pm.templates = append(pm.templates, template)
+	}
+	return pm
+}
+
+// Match returns every template that fits the given stem, together with the inferred root letters.
+// More specific templates (those with more fixed letters) are listed first.
+func (pm *patternMatcher) Match(stem string) []Pattern {
+	runeStem := []rune(stem)
+	var patterns []Pattern
+	for _, template := range pm.templates {
+		runeTemplate := []rune(template)
+		if len(runeTemplate) != len(runeStem) {
+			continue
+		}
+		if slots, ok := matchTemplate(runeTemplate, runeStem); ok {
+			root := make([]rune, len(slots))
+			for i, slot := range slots {
+				root[i] = runeStem[slot]
+			}
+			patterns = append(patterns, Pattern{Name: template, Stem: stem, Root: string(root), Slots: slots})
+		}
+	}
+
+	// Prefer templates with more fixed letters, as they carry more morphological information
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return fixedLetters(patterns[i]) > fixedLetters(patterns[j])
+	})
+	return patterns
+}
+
+// Templates returns the list of templates used by the matcher.
+func (pm *patternMatcher) Templates() []string {
+	return append([]string{}, pm.templates...)
+}
+
+// matchTemplate checks the stem against a single template of the same length.
+// Placeholder letters accept any letter, while all other letters of the template must appear verbatim.
+func matchTemplate(template, stem []rune) ([]int, bool) {
+	var slots []int
+	for i, char := range template {
+		if constant.PATTERN_SLOTS[char] {
+			slots = append(slots, i)
+			continue
+		}
+		if stem[i] != char {
+			return nil, false
+		}
+	}
+	return slots, true
+}
+
+// fixedLetters returns the number of letters of the matched template that are not root slots.
+func fixedLetters(p Pattern) int {
+	return len([]rune(p.Name)) - len(p.Slots)
+}
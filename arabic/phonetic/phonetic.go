@@ -0,0 +1,69 @@
+package phonetic
+
+import (
+	"strings"
+)
+
+// keyLength is the number of digits following the leading letter of a phonetic key.
+const keyLength = 3
+
+// letterCodes assigns each consonant to a group of similar-sounding letters. Letters in the same group
+// get the same digit, so that common confusions (ض/ظ، س/ص، ت/ط، ذ/ز) produce the same key.
+// Vowel letters, hamza and teh marbuta are not coded.
+var letterCodes = map[rune]byte{
+	'ب': '1', 'ف': '1',
+	'ج': '2', 'ز': '2', 'س': '2', 'ش': '2', 'ص': '2', 'ث': '2', 'ذ': '2',
+	'ت': '3', 'ط': '3', 'د': '3', 'ض': '3', 'ظ': '3',
+	'ل': '4',
+	'م': '5', 'ن': '5',
+	'ر': '6',
+	'ق': '7', 'ك': '7', 'غ': '7', 'خ': '7',
+	'ح': '8', 'ه': '8', 'ع': '8',
+}
+
+// leadingLetters maps the first letter of a word to a representative of its closest homophones,
+// so that the letter kept at the start of the key is robust to the same confusions.
+var leadingLetters = map[rune]rune{
+	'ظ': 'ض', 'ط': 'ت', 'ص': 'س', 'ث': 'س', 'ذ': 'ز', 'ق': 'ك',
+	'أ': 'ا', 'إ': 'ا', 'آ': 'ا', 'ء': 'ا', 'ؤ': 'ا', 'ئ': 'ا',
+	'ى': 'ي', 'ة': 'ه',
+}
+
+// Encode returns a Soundex-like phonetic key for an unvocalized Arabic word: its normalized first letter
+// followed by three digits coding the next consonants, with repeated codes collapsed and zero padding.
+// Near-homophones such as ضابط and ظابط share the same key.
+func Encode(word string) string {
+	runeWord := []rune(word)
+	if len(runeWord) == 0 {
+		return ""
+	}
+
+	first := runeWord[0]
+	if leading, ok := leadingLetters[first]; ok {
+		first = leading
+	}
+
+	var key strings.Builder
+	key.WriteRune(first)
+	last := letterCodes[runeWord[0]]
+	digits := 0
+	for _, char := range runeWord[1:] {
+		code, ok := letterCodes[char]
+		if !ok {
+			continue
+		}
+		if code == last {
+			continue
+		}
+		key.WriteByte(code)
+		last = code
+		digits++
+		if digits == keyLength {
+			break
+		}
+	}
+	for ; digits < keyLength; digits++ {
+		key.WriteByte('0')
+	}
+	return key.String()
+}
@@ -0,0 +1,54 @@
+package plural
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/loanword"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/pattern"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"strings"
+	"sync"
+)
+
+var (
+	// wordTemplates matches the stems of any word of the default templates.
+	wordTemplates = sync.OnceValue(func() pattern.PatternMatcher {
+		return pattern.NewPatternMatcher(constant.DEFAULT_PATTERN_LIST)
+	})
+	// feminineTemplates matches the feminine singulars ending in TEH MARBUTA.
+	feminineTemplates = sync.OnceValue(func() pattern.PatternMatcher {
+		return pattern.NewPatternMatcher(constant.FEMININE_SINGULAR_TEMPLATES)
+	})
+	// masculineTemplates matches the participles and adjectives that are the masculine of a feminine form.
+	masculineTemplates = sync.OnceValue(func() pattern.PatternMatcher {
+		return pattern.NewPatternMatcher(constant.MASCULINE_BASE_TEMPLATES)
+	})
+	// pluralTemplates matches the broken plurals, whose final ات may belong to the root, e.g. أصوات.
+	pluralTemplates = sync.OnceValue(func() pattern.PatternMatcher {
+		templates := make([]string, 0, len(constant.BROKEN_PLURAL_TEMPLATES))
+		for template := range constant.BROKEN_PLURAL_TEMPLATES {
+			templates = append(templates, template)
+		}
+		return pattern.NewPatternMatcher(templates)
+	})
+	// anSingularTemplates matches the singulars ending in ان that the dual of a three-letter noun also fits,
+	// e.g. إنسان or عنوان.
+	anSingularTemplates = sync.OnceValue(func() pattern.PatternMatcher {
+		return pattern.NewPatternMatcher([]string{"فعلان"})
+	})
+	// loanwords detects the transliterated foreign words, whose endings aren't Arabic suffixes, e.g. باكستان.
+	loanwords = sync.OnceValue(func() loanword.LoanwordDetector {
+		return loanword.NewLoanwordDetector(constant.LOANWORDS, constant.LOANWORD_MORPHEMES, constant.DEFAULT_LOANWORD_THRESHOLD)
+	})
+)
+
+// attested reports whether a word, without its definite article, matches one of the templates with a root
+// of the dictionary.
+func attested(word string, templates pattern.PatternMatcher, rootsManager roots.RootsManager) bool {
+	word = strings.TrimPrefix(word, constant.ALEF+constant.LAM)
+	for _, p := range templates.Match(word) {
+		if rootsManager.IsRoot(rootsManager.NormalizeRoot(p.Root)) {
+			return true
+		}
+	}
+	return false
+}
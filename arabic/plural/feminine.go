@@ -0,0 +1,115 @@
+package plural
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"strings"
+	"sync"
+)
+
+// FeminineBase selects the base AnalyzeFeminine produces for feminine forms.
+type FeminineBase int
+
+const (
+	// FeminineBaseSingular reduces duals and plurals to the feminine singular, e.g. معلمات → معلمة. This is the default.
+	FeminineBaseSingular FeminineBase = iota
+	// FeminineBaseMasculine reduces feminine forms of participles, adjectives and nisba adjectives to their
+	// masculine singular, e.g. معلمات → معلم or عربية → عربي, and other feminine forms to their feminine singular.
+	FeminineBaseMasculine
+	// FeminineBaseKeep keeps the word as its own base, only reporting its features.
+	FeminineBaseKeep
+)
+
+// FeminineFeatures holds the result of feminine ending analysis.
+type FeminineFeatures struct {
+	// Word is the analyzed word.
+	Word string
+	// Base is the word reduced as selected by the FeminineBase.
+	Base string
+	// Suffix is the feminine ending that was identified (ة, ات, تان or تين), or an empty string.
+	Suffix string
+	Number Number
+	Gender Gender
+}
+
+// feminineSuffixes lists the recognized endings, longest first.
+var feminineSuffixes = []numberSuffix{
+	{"تان", constant.TEH_MARBUTA, NumberDual, GenderFeminine},
+	{"تين", constant.TEH_MARBUTA, NumberDual, GenderFeminine},
+	{"ات", constant.TEH_MARBUTA, NumberPlural, GenderFeminine},
+	{constant.TEH_MARBUTA, constant.TEH_MARBUTA, NumberSingular, GenderFeminine},
+}
+
+// feminineNouns returns the set of the nouns of constant.FEMININE_NOUNS, built on first use.
+var feminineNouns = sync.OnceValue(func() map[string]bool {
+	nouns := make(map[string]bool, len(constant.FEMININE_NOUNS))
+	for _, noun := range constant.FEMININE_NOUNS {
+		nouns[noun] = true
+	}
+	return nouns
+})
+
+// AnalyzeFeminine identifies the feminine endings ة, ات, تان and تين of an unvocalized word and reports its number
+// and gender with the base selected. Unlike root normalization, which drops the TEH MARBUTA, the ending is kept
+// in the base unless it is reduced to a masculine one. Words whose remaining base, not counting a definite article,
+// would be shorter than minStemLength are reported without a feminine ending.
+//
+// Endings are confirmed against the roots dictionary as in AnalyzeNumber: ات is only replaced by TEH MARBUTA when
+// the feminine singular matches a template with a dictionary root, e.g. معلمات → معلمة, and is otherwise dropped
+// with an unknown gender, e.g. امتحانات → امتحان. تان and تين need an attested feminine singular, so that
+// باكستان or بروتين have no ending, and the ات of broken plurals such as أصوات isn't an ending.
+func AnalyzeFeminine(word string, base FeminineBase, minStemLength int, rootsManager roots.RootsManager) FeminineFeatures {
+	features := FeminineFeatures{Word: word, Base: word, Number: NumberSingular, Gender: GenderUnknown}
+	if loanwords().IsLoanword(word) {
+		return features
+	}
+	for _, fs := range feminineSuffixes {
+		stem, found := strings.CutSuffix(word, fs.suffix)
+		if !found {
+			continue
+		}
+		bare := strings.TrimPrefix(stem, constant.ALEF+constant.LAM)
+		if len([]rune(bare)) < minStemLength {
+			continue
+		}
+		singular, gender := stem+fs.singular, fs.gender
+		switch fs.suffix {
+		case "تان", "تين":
+			if !attested(singular, feminineTemplates(), rootsManager) {
+				continue
+			}
+		case "ات":
+			if attested(word, pluralTemplates(), rootsManager) {
+				return features
+			}
+			if !attested(singular, feminineTemplates(), rootsManager) {
+				singular, gender = stem, GenderUnknown
+			}
+		}
+		features.Suffix = fs.suffix
+		features.Number = fs.number
+		features.Gender = gender
+		switch {
+		case base == FeminineBaseKeep:
+		case base == FeminineBaseMasculine && gender == GenderFeminine && masculineForm(bare, rootsManager):
+			features.Base = stem
+		default:
+			features.Base = singular
+		}
+		break
+	}
+	return features
+}
+
+// masculineForm reports whether a base, without its feminine ending and definite article, is the masculine form
+// of the word: a participle or adjective of constant.MASCULINE_BASE_TEMPLATES with a root of the dictionary
+// (معلم, كاتب, جميل) or a nisba adjective (عربي).
+func masculineForm(bare string, rootsManager roots.RootsManager) bool {
+	if feminineNouns()[bare+constant.TEH_MARBUTA] {
+		return false
+	}
+	if strings.HasSuffix(bare, constant.YEH) && len([]rune(bare)) >= 3 {
+		return true
+	}
+	return attested(bare, masculineTemplates(), rootsManager)
+}
@@ -0,0 +1,43 @@
+package plural
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"testing"
+)
+
+func TestAnalyzeFeminine(t *testing.T) {
+	tests := []struct {
+		word      string
+		singular  string
+		masculine string
+		number    Number
+	}{
+		{"معلمات", "معلمة", "معلم", NumberPlural},
+		{"المعلمات", "المعلمة", "المعلم", NumberPlural},
+		{"طالبتان", "طالبة", "طالب", NumberDual},
+		{"عربية", "عربية", "عربي", NumberSingular},
+		{"جميلة", "جميلة", "جميل", NumberSingular},
+		{"امتحانات", "امتحان", "امتحان", NumberPlural},
+		{"اجتماعات", "اجتماع", "اجتماع", NumberPlural},
+		{"أصوات", "أصوات", "أصوات", NumberSingular},
+		{"باكستان", "باكستان", "باكستان", NumberSingular},
+		{"بروتين", "بروتين", "بروتين", NumberSingular},
+		{"جامعة", "جامعة", "جامعة", NumberSingular},
+		{"جائزة", "جائزة", "جائزة", NumberSingular},
+		{"عقيدة", "عقيدة", "عقيدة", NumberSingular},
+		{"كنيسة", "كنيسة", "كنيسة", NumberSingular},
+		{"مباراة", "مباراة", "مباراة", NumberSingular},
+		{"مساعدة", "مساعدة", "مساعدة", NumberSingular},
+		{"مفاوضات", "مفاوضة", "مفاوضة", NumberPlural},
+	}
+	rootsManager := roots.NewRootsManager()
+	for _, tt := range tests {
+		if got := AnalyzeFeminine(tt.word, FeminineBaseSingular, constant.DEFAULT_MIN_STEM, rootsManager); got.Base != tt.singular || got.Number != tt.number {
+			t.Errorf("AnalyzeFeminine(%q, FeminineBaseSingular) = %q %s, want %q %s", tt.word, got.Base, got.Number, tt.singular, tt.number)
+		}
+		if got := AnalyzeFeminine(tt.word, FeminineBaseMasculine, constant.DEFAULT_MIN_STEM, rootsManager); got.Base != tt.masculine {
+			t.Errorf("AnalyzeFeminine(%q, FeminineBaseMasculine) = %q, want %q", tt.word, got.Base, tt.masculine)
+		}
+	}
+}
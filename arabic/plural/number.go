@@ -0,0 +1,109 @@
+package plural
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"strings"
+)
+
+// Number describes the grammatical number of a noun.
+type Number string
+
+// Gender describes the grammatical gender of a noun.
+type Gender string
+
+const (
+	NumberSingular     Number = "singular"
+	NumberDual         Number = "dual"
+	NumberPlural       Number = "plural"
+	NumberDualOrPlural Number = "dual_or_plural"
+
+	GenderUnknown   Gender = ""
+	GenderMasculine Gender = "masculine"
+	GenderFeminine  Gender = "feminine"
+)
+
+// NumberFeatures holds the result of dual and sound plural suffix analysis.
+type NumberFeatures struct {
+	// Word is the analyzed word.
+	Word string
+	// Singular is the word with its number suffix replaced by the singular ending.
+	Singular string
+	// Suffix is the number suffix that was identified, or an empty string.
+	Suffix string
+	Number Number
+	Gender Gender
+}
+
+// numberSuffix describes a dual or sound plural ending and the singular ending that replaces it.
+type numberSuffix struct {
+	suffix   string
+	singular string
+	number   Number
+	gender   Gender
+}
+
+// numberSuffixes lists the recognized endings, longest first so that تان wins over ان.
+var numberSuffixes = []numberSuffix{
+	{"تان", constant.TEH_MARBUTA, NumberDual, GenderFeminine},
+	{"تين", constant.TEH_MARBUTA, NumberDual, GenderFeminine},
+	{"ات", constant.TEH_MARBUTA, NumberPlural, GenderFeminine},
+	{"ون", "", NumberPlural, GenderMasculine},
+	{"ان", "", NumberDual, GenderMasculine},
+	{"ين", "", NumberDualOrPlural, GenderMasculine},
+}
+
+// AnalyzeNumber identifies dual (ان/ين/تان/تين) and sound plural (ون/ين/ات) endings of an unvocalized word.
+// It returns the number and gender features together with the normalized singular. Words whose remaining base,
+// not counting a definite article, would be shorter than minStemLength are reported as singular.
+// The ين ending is ambiguous between the dual and the masculine plural in the oblique case.
+//
+// Endings are confirmed against the roots dictionary: ات is only replaced by TEH MARBUTA when the feminine singular
+// matches a template with a dictionary root, e.g. معلمات → معلمة, and is otherwise dropped with an unknown gender,
+// e.g. امتحانات → امتحان; the ات of broken plurals such as أصوات isn't an ending. تان and تين need an attested
+// feminine singular, and ان a base with a dictionary root that isn't a singular of the فعلان template, e.g. إنسان.
+// Loanwords such as باكستان have no ending.
+func AnalyzeNumber(word string, minStemLength int, rootsManager roots.RootsManager) NumberFeatures {
+	features := NumberFeatures{Word: word, Singular: word, Number: NumberSingular, Gender: GenderUnknown}
+	if strings.HasSuffix(word, constant.TEH_MARBUTA) {
+		features.Gender = GenderFeminine
+	}
+	if loanwords().IsLoanword(word) {
+		return features
+	}
+
+	for _, ns := range numberSuffixes {
+		if !strings.HasSuffix(word, ns.suffix) {
+			continue
+		}
+		base := strings.TrimSuffix(word, ns.suffix)
+		if len([]rune(strings.TrimPrefix(base, constant.ALEF+constant.LAM))) < minStemLength {
+			continue
+		}
+		singular, gender := base+ns.singular, ns.gender
+		switch ns.suffix {
+		case "تان", "تين":
+			if !attested(singular, feminineTemplates(), rootsManager) {
+				continue
+			}
+		case "ات":
+			if attested(word, pluralTemplates(), rootsManager) {
+				return features
+			}
+			if !attested(singular, feminineTemplates(), rootsManager) {
+				singular, gender = base, GenderUnknown
+			}
+		case "ان":
+			if !attested(base, wordTemplates(), rootsManager) ||
+				attested(word, anSingularTemplates(), rootsManager) {
+				continue
+			}
+		}
+		features.Singular = singular
+		features.Suffix = ns.suffix
+		features.Number = ns.number
+		features.Gender = gender
+		break
+	}
+	return features
+}
@@ -0,0 +1,36 @@
+package plural
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"testing"
+)
+
+func TestAnalyzeNumber(t *testing.T) {
+	tests := []struct {
+		word     string
+		singular string
+		number   Number
+		gender   Gender
+	}{
+		{"معلمات", "معلمة", NumberPlural, GenderFeminine},
+		{"سيارات", "سيارة", NumberPlural, GenderFeminine},
+		{"امتحانات", "امتحان", NumberPlural, GenderUnknown},
+		{"حيوانات", "حيوان", NumberPlural, GenderUnknown},
+		{"أصوات", "أصوات", NumberSingular, GenderUnknown},
+		{"طالبتان", "طالبة", NumberDual, GenderFeminine},
+		{"باكستان", "باكستان", NumberSingular, GenderUnknown},
+		{"معلمان", "معلم", NumberDual, GenderMasculine},
+		{"إنسان", "إنسان", NumberSingular, GenderUnknown},
+		{"عنوان", "عنوان", NumberSingular, GenderUnknown},
+		{"المعلمون", "المعلم", NumberPlural, GenderMasculine},
+	}
+	rootsManager := roots.NewRootsManager()
+	for _, tt := range tests {
+		got := AnalyzeNumber(tt.word, constant.DEFAULT_MIN_STEM, rootsManager)
+		if got.Singular != tt.singular || got.Number != tt.number || got.Gender != tt.gender {
+			t.Errorf("AnalyzeNumber(%q) = %q %s %s, want %q %s %s",
+				tt.word, got.Singular, got.Number, got.Gender, tt.singular, tt.number, tt.gender)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package plural
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/pattern"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+)
+
+type PluralResolver interface {
+	Singularize(word string) (string, bool)
+}
+
+// pluralResolver resolves broken plurals to their singular form.
+type pluralResolver struct {
+	templates      map[string][]string
+	exceptions     map[string]string
+	singulars      map[string]bool
+	patternMatcher pattern.PatternMatcher
+	rootsManager   roots.RootsManager
+}
+
+// NewPluralResolver creates a new instance of PluralResolver with the provided template mapping and exceptions dictionary.
+// Template based resolution only accepts roots known to the RootsManager, which keeps false positives in check,
+// and the singulars of constant.BROKEN_PLURAL_SINGULARS, such as دخول, are never resolved.
+func NewPluralResolver(templates map[string][]string, exceptions map[string]string, rootsManager roots.RootsManager) PluralResolver {
+	pluralTemplates := make([]string, 0, len(templates))
+	for template := range templates {
+		pluralTemplates = append(pluralTemplates, template)
+	}
+	singulars := make(map[string]bool, len(exceptions)+len(constant.BROKEN_PLURAL_SINGULARS))
+	for _, singular := range exceptions {
+		singulars[singular] = true
+	}
+	for _, singular := range constant.BROKEN_PLURAL_SINGULARS {
+		singulars[singular] = true
+	}
+	return &pluralResolver{
+		templates:      templates,
+		exceptions:     exceptions,
+		singulars:      singulars,
+		patternMatcher: pattern.NewPatternMatcher(pluralTemplates),
+		rootsManager:   rootsManager,
+	}
+}
+
+// Singularize returns the singular form of the given broken plural and true, or the word itself and false
+// if it isn't recognized as a broken plural. The exceptions dictionary is consulted before the template mapping.
+func (pr *pluralResolver) Singularize(word string) (string, bool) {
+	if singular, exists := pr.exceptions[word]; exists {
+		return singular, true
+	}
+	if pr.singulars[word] {
+		return word, false
+	}
+
+	for _, p := range pr.patternMatcher.Match(word) {
+		if !pr.rootsManager.IsRoot(pr.rootsManager.NormalizeRoot(p.Root)) {
+			continue
+		}
+		for _, template := range pr.templates[p.Name] {
+			if singular, err := pattern.Generate(p.Root, template); err == nil {
+				return singular, true
+			}
+		}
+	}
+	return word, false
+}
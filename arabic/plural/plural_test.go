@@ -0,0 +1,29 @@
+package plural
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"testing"
+)
+
+func TestSingularize(t *testing.T) {
+	tests := []struct {
+		word     string
+		singular string
+		ok       bool
+	}{
+		{"مطاعم", "مطعم", true},
+		{"مصانع", "مصنع", true},
+		{"مدارس", "مدرسة", true},
+		{"قلوب", "قلب", true},
+		{"دخول", "دخول", false},
+		{"خروج", "خروج", false},
+		{"عمود", "عمود", false},
+	}
+	pr := NewPluralResolver(constant.BROKEN_PLURAL_TEMPLATES, constant.BROKEN_PLURAL_EXCEPTIONS, roots.NewRootsManager())
+	for _, tt := range tests {
+		if singular, ok := pr.Singularize(tt.word); singular != tt.singular || ok != tt.ok {
+			t.Errorf("Singularize(%q) = %q, %v, want %q, %v", tt.word, singular, ok, tt.singular, tt.ok)
+		}
+	}
+}
@@ -4,13 +4,65 @@ import (
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
 	"regexp"
 	"strings"
+	"sync"
 )
 
+// Cached patterns, compiled on first use and shared afterwards. A regexp.Regexp is safe for concurrent use,
+// but the shared patterns must not be modified, e.g. with Longest.
+var (
+	harakat     = sync.OnceValue(CreateHarakatPattern)
+	hamzat      = sync.OnceValue(CreateHamzatPattern)
+	alefat      = sync.OnceValue(CreateAlefatPattern)
+	lamAlefat   = sync.OnceValue(CreateLamAlefatPattern)
+	tatweel     = sync.OnceValue(CreateTatwaalPattern)
+	tehMarbuta  = sync.OnceValue(CreateTehMarbutaPattern)
+	alefMaksura = sync.OnceValue(CreateAlefMaksuraPattern)
+)
+
+// Harakat returns the cached pattern matching the harakat, i.e. the short vowels, tanween, sukun and shadda.
+func Harakat() *regexp.Regexp {
+	return harakat()
+}
+
+// Hamzat returns the cached pattern matching the WAW and YEH carrying a hamza.
+func Hamzat() *regexp.Regexp {
+	return hamzat()
+}
+
+// Alefat returns the cached pattern matching the ALEF forms carrying a hamza or a madda, and the combining hamzas.
+func Alefat() *regexp.Regexp {
+	return alefat()
+}
+
+// LamAlefat returns the cached pattern matching the LAM ALEF presentation-form ligatures.
+func LamAlefat() *regexp.Regexp {
+	return lamAlefat()
+}
+
+// Tatweel returns the cached pattern matching the TATWEEL, or kashida.
+func Tatweel() *regexp.Regexp {
+	return tatweel()
+}
+
+// TehMarbuta returns the cached pattern matching the TEH MARBUTA.
+func TehMarbuta() *regexp.Regexp {
+	return tehMarbuta()
+}
+
+// AlefMaksura returns the cached pattern matching the ALEF MAKSURA.
+func AlefMaksura() *regexp.Regexp {
+	return alefMaksura()
+}
+
 // CreatePattern generates a regular expression pattern from a list of characters.
+// It compiles a new pattern on every call, so prefer the cached patterns where one exists.
 func CreatePattern(chars ...string) *regexp.Regexp {
 	return regexp.MustCompile("[" + strings.Join(chars, "") + "]")
 }
 
+// CreateHarakatPattern compiles a new pattern on every call.
+//
+// Deprecated: Use Harakat, which is compiled once.
 func CreateHarakatPattern() *regexp.Regexp {
 	return CreatePattern(
 		constant.FATHATAN,
@@ -24,6 +76,9 @@ func CreateHarakatPattern() *regexp.Regexp {
 	)
 }
 
+// CreateHamzatPattern compiles a new pattern on every call.
+//
+// Deprecated: Use Hamzat, which is compiled once.
 func CreateHamzatPattern() *regexp.Regexp {
 	return CreatePattern(
 		constant.WAW_HAMZA,
@@ -31,6 +86,9 @@ func CreateHamzatPattern() *regexp.Regexp {
 	)
 }
 
+// CreateAlefatPattern compiles a new pattern on every call.
+//
+// Deprecated: Use Alefat, which is compiled once.
 func CreateAlefatPattern() *regexp.Regexp {
 	return CreatePattern(
 		constant.ALEF_MADDA,
@@ -41,6 +99,9 @@ func CreateAlefatPattern() *regexp.Regexp {
 	)
 }
 
+// CreateLamAlefatPattern compiles a new pattern on every call.
+//
+// Deprecated: Use LamAlefat, which is compiled once.
 func CreateLamAlefatPattern() *regexp.Regexp {
 	return CreatePattern(
 		constant.LAM_ALEF,
@@ -50,14 +111,23 @@ func CreateLamAlefatPattern() *regexp.Regexp {
 	)
 }
 
+// CreateTatwaalPattern compiles a new pattern on every call.
+//
+// Deprecated: Use Tatweel, which is compiled once.
 func CreateTatwaalPattern() *regexp.Regexp {
 	return CreatePattern(constant.TATWEEL)
 }
 
+// CreateTehMarbutaPattern compiles a new pattern on every call.
+//
+// Deprecated: Use TehMarbuta, which is compiled once.
 func CreateTehMarbutaPattern() *regexp.Regexp {
 	return CreatePattern(constant.TEH_MARBUTA)
 }
 
+// CreateAlefMaksuraPattern compiles a new pattern on every call.
+//
+// Deprecated: Use AlefMaksura, which is compiled once.
 func CreateAlefMaksuraPattern() *regexp.Regexp {
 	return CreatePattern(constant.ALEF_MAKSURA)
 }
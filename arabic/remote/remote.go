@@ -0,0 +1,192 @@
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when a downloaded or cached dictionary doesn't match its expected SHA-256 checksum.
+var ErrChecksumMismatch = errors.New("remote: checksum mismatch")
+
+// Source is a dictionary served over HTTP(S).
+type Source struct {
+	URL string
+	// SHA256 is the expected hexadecimal SHA-256 checksum of the dictionary. An empty checksum isn't verified.
+	SHA256 string
+}
+
+// Dictionaries names the remote sources of the stemmer's dictionaries. Sources with an empty URL are not fetched.
+type Dictionaries struct {
+	Stopwords Source
+	Roots     Source
+	Prefixes  Source
+	Suffixes  Source
+}
+
+type Fetcher interface {
+	Fetch(ctx context.Context, source Source) (string, error)
+	FetchDictionaries(ctx context.Context, dictionaries Dictionaries) (stemmer.DictionaryFiles, error)
+}
+
+// fetcher downloads dictionaries into a local cache directory.
+type fetcher struct {
+	client   *http.Client
+	cacheDir string
+}
+
+// cacheMetadata holds the validators of a cached dictionary, sent back on the next request.
+type cacheMetadata struct {
+	ETag         string `json:"etag"`
+	LastModified string `json:"last_modified"`
+}
+
+// NewFetcher creates a new instance of Fetcher storing the dictionaries in cacheDir.
+// If client is nil, http.DefaultClient is used.
+func NewFetcher(client *http.Client, cacheDir string) Fetcher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &fetcher{client: client, cacheDir: cacheDir}
+}
+
+// Fetch downloads the source into the cache and returns the path of the local copy.
+// A cached copy is revalidated with If-None-Match and If-Modified-Since, and reused if the server answers
+// 304 Not Modified. Both downloaded and reused copies are verified against the expected checksum, and a
+// download that fails verification never replaces the cached copy.
+func (f *fetcher) Fetch(ctx context.Context, source Source) (string, error) {
+	if err := os.MkdirAll(f.cacheDir, 0o755); err != nil {
+		return "", err
+	}
+	key := sha256.Sum256([]byte(source.URL))
+	dataPath := filepath.Join(f.cacheDir, hex.EncodeToString(key[:]))
+	metaPath := dataPath + ".json"
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL, nil)
+	if err != nil {
+		return "", err
+	}
+	if metadata, ok := readMetadata(metaPath); ok && fileExists(dataPath) {
+		if metadata.ETag != "" {
+			request.Header.Set("If-None-Match", metadata.ETag)
+		}
+		if metadata.LastModified != "" {
+			request.Header.Set("If-Modified-Since", metadata.LastModified)
+		}
+	}
+
+	response, err := f.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusNotModified:
+		if err := verifyFile(dataPath, source.SHA256); err != nil {
+			return "", err
+		}
+		return dataPath, nil
+	case http.StatusOK:
+	default:
+		return "", fmt.Errorf("remote: fetching %s: %s", source.URL, response.Status)
+	}
+
+	// Download to a temporary file, and only replace the cached copy once the checksum is verified
+	temp, err := os.CreateTemp(f.cacheDir, "download-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(temp.Name())
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(temp, hash), response.Body)
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+	if !checksumMatches(hash.Sum(nil), source.SHA256) {
+		return "", fmt.Errorf("%w for %s", ErrChecksumMismatch, source.URL)
+	}
+	if err := os.Rename(temp.Name(), dataPath); err != nil {
+		return "", err
+	}
+
+	metadata := cacheMetadata{ETag: response.Header.Get("ETag"), LastModified: response.Header.Get("Last-Modified")}
+	if data, err := json.Marshal(metadata); err == nil {
+		_ = os.WriteFile(metaPath, data, 0o644)
+	}
+	return dataPath, nil
+}
+
+// FetchDictionaries fetches every dictionary with a URL and returns the local files, ready to be passed
+// to SetDictionaryFiles before calling Reload. It stops at the first dictionary that can't be fetched.
+func (f *fetcher) FetchDictionaries(ctx context.Context, dictionaries Dictionaries) (stemmer.DictionaryFiles, error) {
+	var files stemmer.DictionaryFiles
+	for _, d := range []struct {
+		source Source
+		path   *string
+	}{
+		{dictionaries.Stopwords, &files.Stopwords},
+		{dictionaries.Roots, &files.Roots},
+		{dictionaries.Prefixes, &files.Prefixes},
+		{dictionaries.Suffixes, &files.Suffixes},
+	} {
+		if d.source.URL == "" {
+			continue
+		}
+		path, err := f.Fetch(ctx, d.source)
+		if err != nil {
+			return stemmer.DictionaryFiles{}, err
+		}
+		*d.path = path
+	}
+	return files, nil
+}
+
+// readMetadata reads the validators of a cached dictionary.
+func readMetadata(path string) (cacheMetadata, bool) {
+	var metadata cacheMetadata
+	data, err := os.ReadFile(path)
+	if err != nil || json.Unmarshal(data, &metadata) != nil {
+		return cacheMetadata{}, false
+	}
+	return metadata, true
+}
+
+// verifyFile checks the file against the expected checksum, if any.
+func verifyFile(path, expected string) error {
+	if expected == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	if !checksumMatches(sum[:], expected) {
+		return fmt.Errorf("%w for %s", ErrChecksumMismatch, path)
+	}
+	return nil
+}
+
+// checksumMatches compares a checksum with its expected hexadecimal form. An empty expected checksum always matches.
+func checksumMatches(sum []byte, expected string) bool {
+	return expected == "" || strings.EqualFold(hex.EncodeToString(sum), strings.TrimSpace(expected))
+}
+
+// fileExists checks if a regular file exists at the given path.
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
@@ -0,0 +1,42 @@
+package roots
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultRootLetters are the letters of the roots of the dictionary: the 28 consonants, with HAMZA as the
+// only form of hamza. ALEF, TEH MARBUTA and ALEF MAKSURA never occur in a root.
+const DefaultRootLetters = "ءبتثجحخدذرزسشصضطظعغفقكلمنهوي"
+
+// RootPolicy defines the candidate roots considered valid, both when the stemmer chooses a root and when
+// the roots manager filters candidates before looking them up.
+type RootPolicy struct {
+	// MinLength and MaxLength bound the number of letters of a root.
+	MinLength int `json:"min_length" yaml:"min_length"`
+	MaxLength int `json:"max_length" yaml:"max_length"`
+	// Letters are the letters a root may hold. An empty string allows every letter.
+	Letters string `json:"letters" yaml:"letters"`
+}
+
+// DefaultRootPolicy returns the policy used by default: triliteral and quadriliteral roots of DefaultRootLetters.
+func DefaultRootPolicy() RootPolicy {
+	return RootPolicy{MinLength: 3, MaxLength: 4, Letters: DefaultRootLetters}
+}
+
+// Valid reports whether the root has an allowed number of letters and only allowed letters.
+func (p RootPolicy) Valid(root string) bool {
+	length := utf8.RuneCountInString(root)
+	if length < p.MinLength || length > p.MaxLength {
+		return false
+	}
+	if p.Letters == "" {
+		return true
+	}
+	for _, letter := range root {
+		if !strings.ContainsRune(p.Letters, letter) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,14 @@
+package roots
+
+// QuadriliteralPolicy controls how four-letter roots (دحرج، ترجم، بعثر) compete with triliteral roots.
+type QuadriliteralPolicy int
+
+const (
+	// QuadriliteralAllow accepts four-letter roots found by segmentation alongside triliteral roots.
+	QuadriliteralAllow QuadriliteralPolicy = iota
+	// QuadriliteralPrefer also considers roots inferred from quadriliteral templates and prefers a four-letter
+	// dictionary root over triliteral candidates, e.g. مترجم → ترجم rather than رجم.
+	QuadriliteralPrefer
+	// QuadriliteralDeny never returns four-letter roots.
+	QuadriliteralDeny
+)
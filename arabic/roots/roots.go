@@ -2,8 +2,9 @@ package roots
 
 import (
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
-	"strings"
+	"sync/atomic"
 )
 
 type RootsManager interface {
@@ -13,36 +14,82 @@ type RootsManager interface {
 	FilterRootLengthValid(roots []string) []string
 	LookupRoots(roots []string) []string
 	ChooseRoot(affixationList []map[string]string) string
+	NearestRoots(candidate string, maxDist int) []string
+	Roots() []string
+	Reload(roots []string)
+	Policy() RootPolicy
+	WithPolicy(policy RootPolicy) RootsManager
+}
+
+// PrefixIndex is implemented by roots managers able to search their dictionary by prefix, such as those
+// created by NewRootsManager. It is kept apart from RootsManager so that other implementations needn't provide it.
+type PrefixIndex interface {
+	RootsWithPrefix(prefix string) []string
+	HasRootPrefix(prefix string) bool
 }
 
 type rootsManager struct {
-	roots map[string]bool
+	set    *atomic.Pointer[rootSet]
+	policy RootPolicy
+}
+
+// rootSet holds the roots dictionary with its lookup structures, replaced as a whole on reload.
+type rootSet struct {
+	list []string
+	trie *rootTrie
 }
 
 // NewRootsManager creates a new instance of rootsManager with the provided roots map.
 func NewRootsManager() RootsManager {
-	roots := make(map[string]bool)
-	for _, root := range constant.ROOTS {
-		roots[root] = true
+	r := &rootsManager{set: new(atomic.Pointer[rootSet]), policy: DefaultRootPolicy()}
+	r.Reload(constant.Roots())
+	return r
+}
+
+// newRootSet builds the lookup structures of the given roots.
+func newRootSet(list []string) *rootSet {
+	set := &rootSet{list: list, trie: newRootTrie()}
+	for _, root := range list {
+		set.trie.insert(root)
 	}
-	return &rootsManager{roots: roots}
+	return set
 }
 
 // IsRoot checks if a given word exists as a root in the dictionary.
 func (r *rootsManager) IsRoot(word string) bool {
-	_, exists := r.roots[word]
-	return exists
+	return r.set.Load().trie.contains(word)
+}
+
+// Roots returns the list of roots in the dictionary.
+func (r *rootsManager) Roots() []string {
+	return r.set.Load().list
+}
+
+// Reload replaces the roots dictionary with the given roots.
+// The new dictionary is built before being swapped in atomically, so concurrent lookups see either the old
+// or the new dictionary, never a partial one.
+func (r *rootsManager) Reload(roots []string) {
+	r.set.Store(newRootSet(roots))
+}
+
+// Policy returns the policy defining the valid candidate roots.
+func (r *rootsManager) Policy() RootPolicy {
+	return r.policy
+}
+
+// WithPolicy returns a roots manager applying the given policy, sharing the dictionary of this one,
+// so that a Reload of either is seen by both.
+func (r *rootsManager) WithPolicy(policy RootPolicy) RootsManager {
+	return &rootsManager{set: r.set, policy: policy}
 }
 
 // NormalizeRoot normalizes a given root word by replacing or removing specific characters.
 func (r *rootsManager) NormalizeRoot(word string) string {
-	word = strings.ReplaceAll(word, constant.ALEF_MADDA, constant.HAMZA+constant.ALEF)
-	word = strings.ReplaceAll(word, constant.TEH_MARBUTA, "")
-	word = strings.ReplaceAll(word, constant.ALEF_MAKSURA, constant.YEH)
-	return utils.NormalizeHamza(word)
+	return normalize.Text(word, normalize.RootOptions())
 }
 
 // MostCommon finds and returns the most common string in a given list.
+// Ties between equally frequent strings are broken by choosing the first in lexicographic order.
 func (r *rootsManager) MostCommon(lst []string) string {
 	counts := make(map[string]int)
 	for _, item := range lst {
@@ -52,7 +99,7 @@ func (r *rootsManager) MostCommon(lst []string) string {
 	var mostCommon string
 	maxCount := 0
 	for item, count := range counts {
-		if count > maxCount {
+		if count > maxCount || (count == maxCount && item < mostCommon) {
 			mostCommon = item
 			maxCount = count
 		}
@@ -61,13 +108,12 @@ func (r *rootsManager) MostCommon(lst []string) string {
 	return mostCommon
 }
 
-// FilterRootLengthValid filters a list of roots, returning only those that have a valid length (3-4 characters)
-// and do not contain the ALEF character.
+// FilterRootLengthValid filters a list of roots, returning only those valid under the policy of the manager,
+// by default roots of 3 to 4 letters without ALEF.
 func (r *rootsManager) FilterRootLengthValid(roots []string) []string {
 	var validRoots []string
 	for _, root := range roots {
-		runeRoot := []rune(root)
-		if len(runeRoot) >= 3 && len(runeRoot) <= 4 && !strings.Contains(root, constant.ALEF) {
+		if r.policy.Valid(root) {
 			validRoots = append(validRoots, root)
 		}
 	}
@@ -120,3 +166,37 @@ func (r *rootsManager) ChooseRoot(affixationList []map[string]string) string {
 	}
 	return ""
 }
+
+// NearestRoots returns the dictionary roots within maxDist edits (insertions, deletions or substitutions) of the candidate,
+// closest first and alphabetically among equally close roots. The candidate is normalized before the lookup.
+func (r *rootsManager) NearestRoots(candidate string, maxDist int) []string {
+	if candidate == "" || maxDist < 0 {
+		return nil
+	}
+	var nearest []string
+	for _, match := range r.set.Load().trie.search(r.NormalizeRoot(candidate), maxDist) {
+		nearest = append(nearest, match.root)
+	}
+	return nearest
+}
+
+// RootsWithPrefix returns the dictionary roots starting with the prefix, in lexicographic order.
+// The prefix is normalized before the lookup, and an empty prefix returns every root.
+func (r *rootsManager) RootsWithPrefix(prefix string) []string {
+	node := r.set.Load().trie.node(r.NormalizeRoot(prefix))
+	if node == nil {
+		return nil
+	}
+	return node.collect(nil)
+}
+
+// HasRootPrefix reports whether a dictionary root starts with the prefix, normalized before the lookup.
+func (r *rootsManager) HasRootPrefix(prefix string) bool {
+	return r.set.Load().trie.node(r.NormalizeRoot(prefix)) != nil
+}
+
+// Footprint returns the approximate memory held by the roots dictionary and its trie, in bytes.
+func (r *rootsManager) Footprint() int {
+	set := r.set.Load()
+	return utils.StringsSize(set.list) + set.trie.footprint()
+}
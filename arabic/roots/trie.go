@@ -0,0 +1,134 @@
+package roots
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"sort"
+)
+
+// rootTrie is a rune trie over the roots dictionary, used for exact, prefix and approximate lookups.
+type rootTrie struct {
+	children map[rune]*rootTrie
+	root     string
+}
+
+// newRootTrie creates an empty rootTrie node.
+func newRootTrie() *rootTrie {
+	return &rootTrie{children: make(map[rune]*rootTrie)}
+}
+
+// insert adds a root to the trie.
+func (t *rootTrie) insert(root string) {
+	node := t
+	for _, char := range root {
+		child, exists := node.children[char]
+		if !exists {
+			child = newRootTrie()
+			node.children[char] = child
+		}
+		node = child
+	}
+	node.root = root
+}
+
+// node returns the node reached by the prefix, or nil if no root starts with it.
+func (t *rootTrie) node(prefix string) *rootTrie {
+	node := t
+	for _, char := range prefix {
+		node = node.children[char]
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// contains reports whether the root is in the trie.
+func (t *rootTrie) contains(root string) bool {
+	node := t.node(root)
+	return node != nil && node.root != ""
+}
+
+// collect appends the roots of the subtree of the node to roots, in lexicographic order.
+func (t *rootTrie) collect(roots []string) []string {
+	if t.root != "" {
+		roots = append(roots, t.root)
+	}
+	chars := make([]rune, 0, len(t.children))
+	for char := range t.children {
+		chars = append(chars, char)
+	}
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+	for _, char := range chars {
+		roots = t.children[char].collect(roots)
+	}
+	return roots
+}
+
+// footprint returns the approximate memory held by the trie, in bytes. The roots of the nodes share their
+// bytes with the roots list, so only their headers are counted.
+func (t *rootTrie) footprint() int {
+	size := utils.PointerSize + utils.StringHeaderSize + utils.MapHeaderSize
+	for _, child := range t.children {
+		size += 4 + utils.PointerSize + utils.MapEntryOverhead + child.footprint()
+	}
+	return size
+}
+
+// rootMatch is a root found within a given edit distance.
+type rootMatch struct {
+	root     string
+	distance int
+}
+
+// search returns the roots within maxDist Levenshtein edits of the target, closest first.
+// It walks the trie while computing one row of the edit distance table per node,
+// and prunes branches whose row minimum already exceeds maxDist.
+func (t *rootTrie) search(target string, maxDist int) []rootMatch {
+	runeTarget := []rune(target)
+	firstRow := make([]int, len(runeTarget)+1)
+	for i := range firstRow {
+		firstRow[i] = i
+	}
+
+	var matches []rootMatch
+	for char, child := range t.children {
+		child.searchRecursive(char, runeTarget, firstRow, maxDist, &matches)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].root < matches[j].root
+	})
+	return matches
+}
+
+// searchRecursive computes the edit distance row for the node reached with char and descends into its children.
+func (t *rootTrie) searchRecursive(char rune, target []rune, previousRow []int, maxDist int, matches *[]rootMatch) {
+	columns := len(target) + 1
+	currentRow := make([]int, columns)
+	currentRow[0] = previousRow[0] + 1
+
+	rowMin := currentRow[0]
+	for i := 1; i < columns; i++ {
+		insertCost := currentRow[i-1] + 1
+		deleteCost := previousRow[i] + 1
+		replaceCost := previousRow[i-1]
+		if target[i-1] != char {
+			replaceCost++
+		}
+		currentRow[i] = min(insertCost, deleteCost, replaceCost)
+		rowMin = min(rowMin, currentRow[i])
+	}
+
+	if t.root != "" && currentRow[columns-1] <= maxDist {
+		*matches = append(*matches, rootMatch{root: t.root, distance: currentRow[columns-1]})
+	}
+	if rowMin > maxDist {
+		return
+	}
+	for next, child := range t.children {
+		child.searchRecursive(next, target, currentRow, maxDist, matches)
+	}
+}
@@ -0,0 +1,107 @@
+package sentence
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Sentence is a sentence found in a text.
+type Sentence struct {
+	// Index is the position of the sentence in the text, starting at 0.
+	Index int `json:"index"`
+	// Text is the sentence, without the surrounding whitespace.
+	Text string `json:"text"`
+	// Start and End are the byte offsets of Text in the original text.
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type Splitter interface {
+	Split(text string) []Sentence
+}
+
+// splitter splits text into sentences on terminal punctuation and paragraph breaks.
+type splitter struct {
+	abbreviations map[string]bool
+}
+
+// NewSplitter creates a new instance of Splitter recognizing the given abbreviations, written without their final
+// period. A period ending one of them, e.g. in د. أحمد, does not end the sentence. Use constant.DEFAULT_ABBREVIATIONS
+// for the common Arabic and Latin abbreviations.
+func NewSplitter(abbreviations []string) Splitter {
+	s := &splitter{abbreviations: make(map[string]bool, len(abbreviations))}
+	for _, abbreviation := range abbreviations {
+		s.abbreviations[abbreviation] = true
+	}
+	return s
+}
+
+// Split returns the sentences of the text, in order. A sentence ends at a run of terminal punctuation (. ! ? ؟ ۔ …),
+// together with any closing quotes or brackets following it, or at a blank line. Periods ending an abbreviation or
+// inside a token, as in 3.5 or example.com, do not end a sentence. Sentences without any letter or digit are dropped.
+func (s *splitter) Split(text string) []Sentence {
+	var sentences []Sentence
+	start := 0
+	for i := 0; i < len(text); {
+		char, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case strings.ContainsRune(constant.SENTENCE_TERMINATORS, char) && !s.isInnerPeriod(text, i, char):
+			end := i + size
+			for end < len(text) {
+				next, nextSize := utf8.DecodeRuneInString(text[end:])
+				if !strings.ContainsRune(constant.SENTENCE_TERMINATORS, next) && !isClosing(next) {
+					break
+				}
+				end += nextSize
+			}
+			sentences = appendSentence(sentences, text, start, end)
+			start, i = end, end
+		case char == '\n' && isBlankLine(text[i+size:]):
+			sentences = appendSentence(sentences, text, start, i)
+			start, i = i+size, i+size
+		default:
+			i += size
+		}
+	}
+	return appendSentence(sentences, text, start, len(text))
+}
+
+// isInnerPeriod reports whether the period at the given offset belongs to a number, an abbreviation,
+// or a token such as a domain name where it is directly followed by a letter.
+func (s *splitter) isInnerPeriod(text string, i int, char rune) bool {
+	if char != '.' {
+		return false
+	}
+	previous, _ := utf8.DecodeLastRuneInString(text[:i])
+	next, _ := utf8.DecodeRuneInString(text[i+1:])
+	if unicode.IsDigit(previous) && unicode.IsDigit(next) || unicode.IsLetter(previous) && unicode.IsLetter(next) {
+		return true
+	}
+	word := text[strings.LastIndexFunc(text[:i], unicode.IsSpace)+1 : i]
+	return s.abbreviations[word]
+}
+
+// isBlankLine reports whether the text starts with a line holding only whitespace, i.e. a paragraph break.
+func isBlankLine(text string) bool {
+	line, _, found := strings.Cut(text, "\n")
+	return found && strings.TrimSpace(line) == ""
+}
+
+// isClosing reports whether the character closes a quotation or parenthesis.
+func isClosing(char rune) bool {
+	return unicode.In(char, unicode.Pe, unicode.Pf) || char == '"' || char == '\''
+}
+
+// appendSentence appends the trimmed text between start and end as a sentence, if it holds a letter or digit.
+func appendSentence(sentences []Sentence, text string, start, end int) []Sentence {
+	raw := text[start:end]
+	trimmed := strings.TrimLeftFunc(raw, unicode.IsSpace)
+	start += len(raw) - len(trimmed)
+	trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	if strings.IndexFunc(trimmed, func(char rune) bool { return unicode.IsLetter(char) || unicode.IsDigit(char) }) < 0 {
+		return sentences
+	}
+	return append(sentences, Sentence{Index: len(sentences), Text: trimmed, Start: start, End: start + len(trimmed)})
+}
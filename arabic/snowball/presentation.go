@@ -0,0 +1,37 @@
+package snowball
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+)
+
+// presentationForms maps the Arabic Presentation Forms-B letters, U+FE80 to U+FEFC, to the letters they stand for.
+var presentationForms = buildPresentationForms()
+
+// buildPresentationForms lists the letters of the Presentation Forms-B block in order, each with its number of
+// contextual forms: isolated and final, and initial and medial for the letters joining on both sides.
+func buildPresentationForms() map[rune]string {
+	letters := []struct {
+		letters string
+		forms   int
+	}{
+		{constant.HAMZA, 1}, {constant.ALEF_MADDA, 2}, {constant.ALEF_HAMZA_ABOVE, 2}, {constant.WAW_HAMZA, 2},
+		{constant.ALEF_HAMZA_BELOW, 2}, {constant.YEH_HAMZA, 4}, {constant.ALEF, 2}, {constant.BEH, 4},
+		{constant.TEH_MARBUTA, 2}, {constant.TEH, 4}, {constant.THEH, 4}, {constant.JEEM, 4}, {constant.HAH, 4},
+		{constant.KHAH, 4}, {constant.DAL, 2}, {constant.THAL, 2}, {constant.REH, 2}, {constant.ZAIN, 2},
+		{constant.SEEN, 4}, {constant.SHEEN, 4}, {constant.SAD, 4}, {constant.DAD, 4}, {constant.TAH, 4},
+		{constant.ZAH, 4}, {constant.AIN, 4}, {constant.GHAIN, 4}, {constant.FEH, 4}, {constant.QAF, 4},
+		{constant.KAF, 4}, {constant.LAM, 4}, {constant.MEEM, 4}, {constant.NOON, 4}, {constant.HEH, 4},
+		{constant.WAW, 2}, {constant.ALEF_MAKSURA, 2}, {constant.YEH, 4},
+		{constant.SIMPLE_LAM_ALEF_MADDA_ABOVE, 2}, {constant.SIMPLE_LAM_ALEF_HAMZA_ABOVE, 2},
+		{constant.SIMPLE_LAM_ALEF_HAMZA_BELOW, 2}, {constant.SIMPLE_LAM_ALEF, 2},
+	}
+	forms := make(map[rune]string)
+	char := rune(0xFE80)
+	for _, letter := range letters {
+		for i := 0; i < letter.forms; i++ {
+			forms[char] = letter.letters
+			char++
+		}
+	}
+	return forms
+}
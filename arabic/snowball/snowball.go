@@ -0,0 +1,285 @@
+package snowball
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+)
+
+// rule is a branch of a Snowball among: affixes that are replaced, or removed when the replacement is empty,
+// if the word has at least minLength letters.
+type rule struct {
+	affixes     []string
+	minLength   int
+	replacement string
+}
+
+// Affix rules of the algorithm, named after the Snowball routines using them. Lengths are those of the whole word.
+var (
+	// checks1 marks words starting with the article as defined nouns: بال، كال and لل، ال.
+	checks1 = []rule{
+		{affixes: []string{constant.BEH + constant.ALEF + constant.LAM, constant.KAF + constant.ALEF + constant.LAM}, minLength: 5},
+		{affixes: []string{constant.LAM + constant.LAM, constant.ALEF + constant.LAM}, minLength: 4},
+	}
+
+	// prefixStep1 reduces a doubled initial hamza: أأ، أآ، أا، أإ.
+	prefixStep1 = []rule{
+		{affixes: []string{constant.ALEF_HAMZA_ABOVE + constant.ALEF_HAMZA_ABOVE}, minLength: 4, replacement: constant.ALEF_HAMZA_ABOVE},
+		{affixes: []string{constant.ALEF_HAMZA_ABOVE + constant.ALEF_MADDA}, minLength: 4, replacement: constant.ALEF_MADDA},
+		{affixes: []string{constant.ALEF_HAMZA_ABOVE + constant.ALEF}, minLength: 4, replacement: constant.ALEF},
+		{affixes: []string{constant.ALEF_HAMZA_ABOVE + constant.ALEF_HAMZA_BELOW}, minLength: 4, replacement: constant.ALEF_HAMZA_BELOW},
+	}
+	// prefixStep2 removes the conjunctions ف، و.
+	prefixStep2 = []rule{
+		{affixes: []string{constant.FEH, constant.WAW}, minLength: 4},
+	}
+	// prefixStep3aNoun removes the article: بال، كال and لل، ال.
+	prefixStep3aNoun = []rule{
+		{affixes: []string{constant.BEH + constant.ALEF + constant.LAM, constant.KAF + constant.ALEF + constant.LAM}, minLength: 6},
+		{affixes: []string{constant.LAM + constant.LAM, constant.ALEF + constant.LAM}, minLength: 5},
+	}
+	// prefixStep3bNoun removes the prepositions ب، كك، بب.
+	prefixStep3bNoun = []rule{
+		{affixes: []string{constant.BEH}, minLength: 4},
+		{affixes: []string{constant.KAF + constant.KAF}, minLength: 4, replacement: constant.KAF},
+		{affixes: []string{constant.BEH + constant.BEH}, minLength: 4, replacement: constant.BEH},
+	}
+	// prefixStep3Verb removes the future SEEN: سي، ست، سن، سأ.
+	prefixStep3Verb = []rule{
+		{affixes: []string{constant.SEEN + constant.YEH}, minLength: 5, replacement: constant.YEH},
+		{affixes: []string{constant.SEEN + constant.TEH}, minLength: 5, replacement: constant.TEH},
+		{affixes: []string{constant.SEEN + constant.NOON}, minLength: 5, replacement: constant.NOON},
+		{affixes: []string{constant.SEEN + constant.ALEF_HAMZA_ABOVE}, minLength: 5, replacement: constant.ALEF_HAMZA_ABOVE},
+	}
+	// prefixStep4Verb writes the imperfect of the tenth form as its perfect: يست، نست، تست.
+	prefixStep4Verb = []rule{
+		{affixes: []string{constant.YEH + constant.SEEN + constant.TEH, constant.NOON + constant.SEEN + constant.TEH, constant.TEH + constant.SEEN + constant.TEH},
+			minLength: 5, replacement: constant.ALEF + constant.SEEN + constant.TEH},
+	}
+
+	// suffixNounStep1a removes the possessive pronouns: ي، ك، ه and نا، كم، ها، هن، هم and كما، هما.
+	suffixNounStep1a = []rule{
+		{affixes: []string{constant.YEH, constant.KAF, constant.HEH}, minLength: 4},
+		{affixes: []string{constant.NOON + constant.ALEF, constant.KAF + constant.MEEM, constant.HEH + constant.ALEF, constant.HEH + constant.NOON, constant.HEH + constant.MEEM}, minLength: 5},
+		{affixes: []string{constant.KAF + constant.MEEM + constant.ALEF, constant.HEH + constant.MEEM + constant.ALEF}, minLength: 6},
+	}
+	// suffixNounStep1b removes the NOON of the dual and sound plurals.
+	suffixNounStep1b = []rule{
+		{affixes: []string{constant.NOON}, minLength: 6},
+	}
+	// suffixNounStep2a removes the vowels left by the dual and sound plurals: ا، ي، و.
+	suffixNounStep2a = []rule{
+		{affixes: []string{constant.ALEF, constant.YEH, constant.WAW}, minLength: 5},
+	}
+	// suffixNounStep2b removes the feminine plural ات.
+	suffixNounStep2b = []rule{
+		{affixes: []string{constant.ALEF + constant.TEH}, minLength: 5},
+	}
+	// suffixNounStep2c1 removes the TEH left by a feminine ending.
+	suffixNounStep2c1 = []rule{
+		{affixes: []string{constant.TEH}, minLength: 4},
+	}
+	// suffixNounStep2c2 removes TEH MARBUTA.
+	suffixNounStep2c2 = []rule{
+		{affixes: []string{constant.TEH_MARBUTA}, minLength: 4},
+	}
+	// suffixNounStep3 removes the YEH of relation (nisba).
+	suffixNounStep3 = []rule{
+		{affixes: []string{constant.YEH}, minLength: 3},
+	}
+
+	// suffixVerbStep1 removes the object pronouns: ه، ك and ني، نا، ها، هم، هن، كم، كن and هما، كما، كمو.
+	suffixVerbStep1 = []rule{
+		{affixes: []string{constant.HEH, constant.KAF}, minLength: 4},
+		{affixes: []string{
+			constant.NOON + constant.YEH, constant.NOON + constant.ALEF, constant.HEH + constant.ALEF, constant.HEH + constant.MEEM,
+			constant.HEH + constant.NOON, constant.KAF + constant.MEEM, constant.KAF + constant.NOON,
+		}, minLength: 5},
+		{affixes: []string{constant.HEH + constant.MEEM + constant.ALEF, constant.KAF + constant.MEEM + constant.ALEF, constant.KAF + constant.MEEM + constant.WAW}, minLength: 6},
+	}
+	// suffixVerbStep2a removes the subject endings: ت، ا، ن, the perfect نا، تا، تن, the imperfect ان، ون، ين and تما.
+	suffixVerbStep2a = []rule{
+		{affixes: []string{constant.TEH, constant.ALEF, constant.NOON}, minLength: 4},
+		{affixes: []string{constant.NOON + constant.ALEF, constant.TEH + constant.ALEF, constant.TEH + constant.NOON}, minLength: 5},
+		{affixes: []string{constant.ALEF + constant.NOON, constant.WAW + constant.NOON, constant.YEH + constant.NOON}, minLength: 6},
+		{affixes: []string{constant.TEH + constant.MEEM + constant.ALEF}, minLength: 6},
+	}
+	// suffixVerbStep2b removes the plural subject endings وا، تم.
+	suffixVerbStep2b = []rule{
+		{affixes: []string{constant.WAW + constant.ALEF, constant.TEH + constant.MEEM}, minLength: 5},
+	}
+	// suffixVerbStep2c removes the subject endings left before an object pronoun: و، تمو.
+	suffixVerbStep2c = []rule{
+		{affixes: []string{constant.WAW}, minLength: 4},
+		{affixes: []string{constant.TEH + constant.MEEM + constant.WAW}, minLength: 6},
+	}
+	// suffixAllAlefMaksura writes a final ALEF MAKSURA as YEH.
+	suffixAllAlefMaksura = []rule{
+		{affixes: []string{constant.ALEF_MAKSURA}, replacement: constant.YEH},
+	}
+)
+
+// stemmer holds the word being stemmed and the properties guessed for it, the booleans of the Snowball program.
+type stemmer struct {
+	word      string
+	isNoun    bool
+	isVerb    bool
+	isDefined bool
+}
+
+// Stem applies the Arabic stemmer of the Snowball project (Assem Chelli), giving the stems of snowballstem based
+// systems such as Solr and Sphinx. After a guess of the part of speech from the article, suffixes are removed
+// as for a verb, or else as for a noun, then prefixes are removed and the hamza forms are normalized.
+// The rules and their length conditions follow arabic.sbl, including its exceptions: a conjunction isn't
+// removed before an ALEF, so that words such as وافق keep their first letter.
+func Stem(word string) string {
+	s := &stemmer{word: word, isNoun: true, isVerb: true}
+	s.checks1()
+	s.word = Normalize(s.word)
+
+	s.suffixes()
+
+	s.prefix(prefixStep1)
+	if !strings.HasPrefix(s.word, constant.FEH+constant.ALEF) && !strings.HasPrefix(s.word, constant.WAW+constant.ALEF) {
+		s.prefix(prefixStep2)
+	}
+	switch {
+	case s.prefix(prefixStep3aNoun):
+	case s.isNoun && !strings.HasPrefix(s.word, constant.BEH+constant.ALEF) && s.prefix(prefixStep3bNoun):
+	case s.isVerb:
+		s.prefix(prefixStep3Verb)
+		s.prefix(prefixStep4Verb)
+	}
+
+	return normalizePost(s.word)
+}
+
+// Normalize applies the pre-stemming normalization of the Snowball stemmer: it removes the diacritics and TATWEEL,
+// writes the Arabic-Indic digits as ASCII digits and the presentation forms as the letters they stand for.
+func Normalize(word string) string {
+	var builder strings.Builder
+	builder.Grow(len(word))
+	for _, char := range word {
+		switch {
+		case char >= 0x064B && char <= 0x0652, string(char) == constant.TATWEEL:
+		case char >= 0x0660 && char <= 0x0669:
+			builder.WriteRune('0' + char - 0x0660)
+		default:
+			if letters, ok := presentationForms[char]; ok {
+				builder.WriteString(letters)
+			} else {
+				builder.WriteRune(char)
+			}
+		}
+	}
+	return builder.String()
+}
+
+// suffixes removes the suffixes of a verb, or, if the word isn't one or has none, of a noun, or else writes
+// a final ALEF MAKSURA as YEH. As in Snowball, suffixes removed by a branch that fails afterwards stay removed.
+func (s *stemmer) suffixes() {
+	if s.isVerb && s.verbSuffixes() {
+		return
+	}
+	if s.isNoun && s.nounSuffixes() {
+		return
+	}
+	s.suffix(suffixAllAlefMaksura)
+}
+
+// verbSuffixes removes object pronouns, then a subject ending, or a subject ending alone.
+func (s *stemmer) verbSuffixes() bool {
+	if s.suffix(suffixVerbStep1) {
+		for s.suffix(suffixVerbStep1) {
+		}
+		if !s.suffix(suffixVerbStep2a) {
+			s.suffix(suffixVerbStep2c)
+		}
+		return true
+	}
+	return s.suffix(suffixVerbStep2b) || s.suffix(suffixVerbStep2a)
+}
+
+// nounSuffixes removes the feminine, possessive and plural endings, then requires a YEH of relation.
+func (s *stemmer) nounSuffixes() bool {
+	switch {
+	case s.suffix(suffixNounStep2c2):
+	case !s.isDefined && s.suffix(suffixNounStep1a):
+		s.pluralSuffix()
+	case s.suffix(suffixNounStep1b) && s.pluralSuffix():
+	case !s.isDefined && s.suffix(suffixNounStep2a):
+	default:
+		s.suffix(suffixNounStep2b)
+	}
+	return s.suffix(suffixNounStep3)
+}
+
+// pluralSuffix removes what remains of a dual, plural or feminine ending.
+func (s *stemmer) pluralSuffix() bool {
+	return s.suffix(suffixNounStep2a) || s.suffix(suffixNounStep2b) || s.suffix(suffixNounStep2c1)
+}
+
+// checks1 guesses that words starting with the article are defined nouns.
+func (s *stemmer) checks1() {
+	if r, _, ok := longestMatch(s.word, checks1, strings.HasPrefix); ok && length(s.word) >= r.minLength {
+		s.isNoun, s.isVerb, s.isDefined = true, false, true
+	}
+}
+
+// prefix applies the rule of the longest prefix of the word found in the rules, reporting whether it applied.
+func (s *stemmer) prefix(rules []rule) bool {
+	r, affix, ok := longestMatch(s.word, rules, strings.HasPrefix)
+	if !ok || length(s.word) < r.minLength {
+		return false
+	}
+	s.word = r.replacement + s.word[len(affix):]
+	return true
+}
+
+// suffix applies the rule of the longest suffix of the word found in the rules, reporting whether it applied.
+func (s *stemmer) suffix(rules []rule) bool {
+	r, affix, ok := longestMatch(s.word, rules, strings.HasSuffix)
+	if !ok || length(s.word) < r.minLength {
+		return false
+	}
+	s.word = s.word[:len(s.word)-len(affix)] + r.replacement
+	return true
+}
+
+// longestMatch returns the rule of the longest affix of the rules matching the word. As in a Snowball among,
+// a failing length condition of that rule doesn't fall back to shorter affixes.
+func longestMatch(word string, rules []rule, match func(string, string) bool) (rule, string, bool) {
+	var best rule
+	longest, found := "", false
+	for _, r := range rules {
+		for _, affix := range r.affixes {
+			if match(word, affix) && len(affix) > len(longest) {
+				best, longest, found = r, affix, true
+			}
+		}
+	}
+	return best, longest, found
+}
+
+// normalizePost writes a final hamza carrier as HAMZA, then the other hamza and madda forms as their bare letters.
+func normalizePost(word string) string {
+	for _, carrier := range []string{constant.ALEF_HAMZA_ABOVE, constant.ALEF_HAMZA_BELOW, constant.ALEF_MADDA, constant.WAW_HAMZA, constant.YEH_HAMZA} {
+		if strings.HasSuffix(word, carrier) {
+			word = strings.TrimSuffix(word, carrier) + constant.HAMZA
+			break
+		}
+	}
+	return hamzaReplacer.Replace(word)
+}
+
+var hamzaReplacer = strings.NewReplacer(
+	constant.ALEF_HAMZA_ABOVE, constant.ALEF,
+	constant.ALEF_HAMZA_BELOW, constant.ALEF,
+	constant.ALEF_MADDA, constant.ALEF,
+	constant.WAW_HAMZA, constant.WAW,
+	constant.YEH_HAMZA, constant.YEH,
+)
+
+// length returns the number of letters of the word.
+func length(word string) int {
+	return len([]rune(word))
+}
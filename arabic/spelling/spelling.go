@@ -0,0 +1,109 @@
+package spelling
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+)
+
+var (
+	alef           = []rune(constant.ALEF)[0]
+	alefHamzaAbove = []rune(constant.ALEF_HAMZA_ABOVE)[0]
+	alefHamzaBelow = []rune(constant.ALEF_HAMZA_BELOW)[0]
+	heh            = []rune(constant.HEH)[0]
+	tehMarbuta     = []rune(constant.TEH_MARBUTA)[0]
+	yeh            = []rune(constant.YEH)[0]
+	alefMaksura    = []rune(constant.ALEF_MAKSURA)[0]
+)
+
+// Corrections returns the variants of an unvocalized word obtained by fixing one common spelling error.
+// A final HEH and TEH MARBUTA are swapped, a final YEH and ALEF MAKSURA are swapped, and a bare ALEF at the start
+// of the word or after the definite article is given its dropped hamza (اسلام → أسلام، إسلام).
+func Corrections(word string) []string {
+	runeWord := []rune(word)
+	if len(runeWord) == 0 {
+		return nil
+	}
+
+	var variants []string
+	last := len(runeWord) - 1
+	switch runeWord[last] {
+	case heh:
+		variants = append(variants, replaceAt(runeWord, last, tehMarbuta))
+	case tehMarbuta:
+		variants = append(variants, replaceAt(runeWord, last, heh))
+	case yeh:
+		variants = append(variants, replaceAt(runeWord, last, alefMaksura))
+	case alefMaksura:
+		variants = append(variants, replaceAt(runeWord, last, yeh))
+	}
+
+	for _, i := range hamzaPositions(word) {
+		if runeWord[i] == alef {
+			variants = append(variants, replaceAt(runeWord, i, alefHamzaAbove), replaceAt(runeWord, i, alefHamzaBelow))
+		}
+	}
+	return variants
+}
+
+// Edits returns the variants of an unvocalized word at a single edit from it: one letter replaced by a letter it is
+// commonly confused with (see CONFUSABLE_LETTERS), two adjacent letters transposed, or one letter deleted.
+// Variants are listed in that order, so that substitutions are preferred over deletions, without duplicates
+// and without the word itself.
+func Edits(word string) []string {
+	runeWord := []rune(word)
+	seen := map[string]bool{word: true}
+	var variants []string
+	add := func(variant string) {
+		if !seen[variant] {
+			seen[variant] = true
+			variants = append(variants, variant)
+		}
+	}
+
+	for i, char := range runeWord {
+		for _, group := range constant.CONFUSABLE_LETTERS {
+			if !containsLetter(group, char) {
+				continue
+			}
+			for _, letter := range group {
+				add(replaceAt(runeWord, i, []rune(letter)[0]))
+			}
+		}
+	}
+	for i := 0; i+1 < len(runeWord); i++ {
+		swapped := append([]rune{}, runeWord...)
+		swapped[i], swapped[i+1] = swapped[i+1], swapped[i]
+		add(string(swapped))
+	}
+	for i := range runeWord {
+		add(string(runeWord[:i]) + string(runeWord[i+1:]))
+	}
+	return variants
+}
+
+// hamzaPositions returns the rune positions where a word-initial hamza may have been dropped:
+// the first letter, and the letter following the definite article.
+func hamzaPositions(word string) []int {
+	positions := []int{0}
+	if strings.HasPrefix(word, constant.ALEF+constant.LAM) && len([]rune(word)) > 2 {
+		positions = append(positions, 2)
+	}
+	return positions
+}
+
+// replaceAt returns a copy of the word with the rune at position i replaced by char.
+func replaceAt(word []rune, i int, char rune) string {
+	replaced := append([]rune{}, word...)
+	replaced[i] = char
+	return string(replaced)
+}
+
+// containsLetter checks if the group contains the given letter.
+func containsLetter(group []string, char rune) bool {
+	for _, letter := range group {
+		if []rune(letter)[0] == char {
+			return true
+		}
+	}
+	return false
+}
@@ -1,42 +1,85 @@
 package stamp
 
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"sort"
+	"sync/atomic"
+)
+
 type VerbListManager interface {
 	IsVerbStamp(stem string) bool
+	IsQuadriliteralStamp(stem string) bool
+	Stamps() []string
+	Reload(verbs []string)
+	WithNormalizer(verbNormalizer VerbNormalizer) VerbListManager
 }
 
-// verbListManager manages the list of verbs.
+// verbListManager manages the list of verbs, stored as the set of their stamps.
 type verbListManager struct {
-	verbList       []string
+	verbs          atomic.Pointer[verbSet]
 	verbNormalizer VerbNormalizer
 }
 
+// verbSet holds the verbs as given and their stamps, replaced as a whole on reload.
+type verbSet struct {
+	verbs  []string
+	stamps utils.Set[string]
+}
+
 // NewVerbListManager creates a new instance of VerbListManager with the provided initial verb list and VerbNormalizer.
 // It initializes the verb list by normalizing the provided verbs using the VerbNormalizer.
 func NewVerbListManager(initialVerbList []string, verbNormalizer VerbNormalizer) VerbListManager {
 	vlm := &verbListManager{
 		verbNormalizer: verbNormalizer,
 	}
-	vlm.initializeVerbList(initialVerbList)
+	vlm.Reload(initialVerbList)
 	return vlm
 }
 
-// initializeVerbList normalizes each verb in the initial verb list and appends it to the internal verb list.
-// This method is called during the creation of the VerbListManager instance.
-func (vlm *verbListManager) initializeVerbList(initialVerbList []string) {
-	for _, verb := range initialVerbList {
-		normalizedVerb := vlm.verbNormalizer.Normalize(verb)
-		vlm.verbList = append(vlm.verbList, normalizedVerb)
+// Reload replaces the verb list with the given verbs, which are normalized into stamps.
+// Stamps are normalized forms already, so a list of precomputed stamps can be loaded as well.
+// The new set is swapped in atomically, so concurrent lookups never see a partial list.
+func (vlm *verbListManager) Reload(verbs []string) {
+	stamps := make(utils.Set[string], len(verbs))
+	for _, verb := range verbs {
+		stamps.Add(vlm.verbNormalizer.Normalize(verb))
 	}
+	vlm.verbs.Store(&verbSet{verbs: append([]string{}, verbs...), stamps: stamps})
+}
+
+// WithNormalizer returns a new manager for the same verbs, normalized into stamps by the given VerbNormalizer.
+// The verbs are normalized again from the form they were given in, so no information lost to the current
+// normalizer is missing from the new stamps.
+func (vlm *verbListManager) WithNormalizer(verbNormalizer VerbNormalizer) VerbListManager {
+	return NewVerbListManager(vlm.verbs.Load().verbs, verbNormalizer)
+}
+
+// Stamps returns the stamps of the verb list, in lexicographic order.
+func (vlm *verbListManager) Stamps() []string {
+	stamps := vlm.verbs.Load().stamps.Values()
+	sort.Strings(stamps)
+	return stamps
 }
 
 // IsVerbStamp checks if the normalized version of the given stem is present in the verb list.
 // It returns true if the normalized stem is found in the list, false otherwise.
 func (vlm *verbListManager) IsVerbStamp(stem string) bool {
-	normalizedStem := vlm.verbNormalizer.Normalize(stem)
-	for _, verb := range vlm.verbList {
-		if verb == normalizedStem {
-			return true
-		}
+	return vlm.verbs.Load().stamps.Has(vlm.verbNormalizer.Normalize(stem))
+}
+
+// IsQuadriliteralStamp checks if the given stem is a derived form of a known quadriliteral verb.
+// It recognizes the تفعلل form (تدحرج) and the افعنلل form (احرنجم) by removing their augment letters
+// and looking the remaining four letters up in the verb list.
+func (vlm *verbListManager) IsQuadriliteralStamp(stem string) bool {
+	runeStem := []rune(stem)
+	switch {
+	case len(runeStem) == 4:
+		return vlm.IsVerbStamp(stem)
+	case len(runeStem) == 5 && string(runeStem[0]) == constant.TEH:
+		return vlm.IsVerbStamp(string(runeStem[1:]))
+	case len(runeStem) == 6 && string(runeStem[0]) == constant.ALEF && string(runeStem[3]) == constant.NOON:
+		return vlm.IsVerbStamp(string(runeStem[1:3]) + string(runeStem[4:]))
 	}
 	return false
 }
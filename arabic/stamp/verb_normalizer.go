@@ -2,13 +2,14 @@ package stamp
 
 import (
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stop_words"
-	"regexp"
 	"strings"
 )
 
 type verbNormalizer struct {
 	wordProcessor stop_words.WordProcessor
+	hamzaLevel    normalize.HamzaLevel
 }
 
 // VerbNormalizer handles the normalization of verbs.
@@ -20,7 +21,22 @@ type VerbNormalizer interface {
 // The WordProcessor is used for various word processing tasks, such as stripping Tashkeel.
 // This function returns a VerbNormalizer interface that can be used to normalize verbs.
 func NewVerbNormalizer(wordProcessor stop_words.WordProcessor) VerbNormalizer {
-	return &verbNormalizer{wordProcessor: wordProcessor}
+	return NewVerbNormalizerWithHamza(wordProcessor, normalize.HamzaFull)
+}
+
+// NewVerbNormalizerWithHamza creates a VerbNormalizer normalizing the hamza forms of verbs to the given level,
+// instead of writing every hamza as a bare HAMZA.
+func NewVerbNormalizerWithHamza(wordProcessor stop_words.WordProcessor, hamzaLevel normalize.HamzaLevel) VerbNormalizer {
+	return &verbNormalizer{wordProcessor: wordProcessor, hamzaLevel: hamzaLevel}
+}
+
+// defaultNormalizer is the normalizer used by Key.
+var defaultNormalizer = NewVerbNormalizer(stop_words.NewWordProcessor(stop_words.NewTashkeelChecker()))
+
+// Key returns the stamp of the verb, the normalized form under which the verb list stores it and looks it up.
+// Use it to precompute a custom stamp table, e.g. for a file loaded as the stemmer's verb list.
+func Key(verb string) string {
+	return defaultNormalizer.Normalize(verb)
 }
 
 // Normalize applies a series of normalization steps to the given verb string.
@@ -54,18 +70,24 @@ func (vn *verbNormalizer) Normalize(verb string) string {
 	return verb
 }
 
-// normalizeHamza normalizes Hamza characters in the given verb string by replacing them with a standard Hamza ('ء').
+// normalizeHamza normalizes Hamza characters in the given verb string to the level of the normalizer.
+// At the default level, they are replaced with a standard Hamza ('ء').
 func (vn *verbNormalizer) normalizeHamza(verb string) string {
-	reHamza := regexp.MustCompile(`[أإءؤئآ]`)
-	return reHamza.ReplaceAllString(verb, "ء")
+	return normalize.Hamza(verb, vn.hamzaLevel)
 }
 
 // removeWeakLetters removes weak letters ('ا', 'و', 'ي', 'ى') from the given verb string.
 func (vn *verbNormalizer) removeWeakLetters(verb string) string {
-	reWeakLetters := regexp.MustCompile(`[اويى]`)
-	return reWeakLetters.ReplaceAllString(verb, "")
+	return weakLetterRemover.Replace(verb)
 }
 
+var weakLetterRemover = strings.NewReplacer(
+	constant.ALEF, "",
+	constant.WAW, "",
+	constant.YEH, "",
+	constant.ALEF_MAKSURA, "",
+)
+
 // removeDoubleLetterAtEnd removes the last character of the verb if it is the same as the second-to-last character,
 // which helps to standardize verbs that end in double letters.
 func (vn *verbNormalizer) removeDoubleLetterAtEnd(verb string) string {
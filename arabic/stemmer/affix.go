@@ -0,0 +1,40 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+)
+
+// SetAffixRules sets the rules allowing prefix-suffix pairs for verbs and nouns, replacing the built-in
+// affix lists. Rules can carry conditions on the stem, e.g. a minimum length or excluded letters, and are
+// checked in addition to the stemmer's own stem checks. It returns ErrEmptyAffixList if rules is empty.
+func (als *ArabicLightStemmer) SetAffixRules(rules []affix.Rule) error {
+	if len(rules) == 0 {
+		return ErrEmptyAffixList
+	}
+	als.affixRules = affix.NewRuleSet(rules)
+	return nil
+}
+
+// GetAffixRules returns the rules allowing prefix-suffix pairs for verbs and nouns.
+// By default they are the unconditional rules of affix.DefaultRules.
+func (als *ArabicLightStemmer) GetAffixRules() []affix.Rule {
+	return als.affixRules.Rules()
+}
+
+// validAffix reports whether the prefix-suffix pair is allowed for the part of speech with the given stem,
+// and whether the stem itself is valid for it.
+func (als *ArabicLightStemmer) validAffix(tag, prefix, suffix, stem string) bool {
+	return als.affixRejection(tag, prefix, suffix, stem) == ""
+}
+
+// SetAffixWeights sets the weights ranking the valid segmentations of a word with StrategyWeighted.
+// They can be learned from a tagged corpus with affix.Learn.
+func (als *ArabicLightStemmer) SetAffixWeights(weights affix.Weights) {
+	als.affixWeights = weights.Clone()
+}
+
+// GetAffixWeights returns the weights ranking the valid segmentations of a word with StrategyWeighted.
+// By default they are affix.DefaultWeights.
+func (als *ArabicLightStemmer) GetAffixWeights() affix.Weights {
+	return als.affixWeights.Clone()
+}
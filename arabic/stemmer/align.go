@@ -0,0 +1,167 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/chars"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"strings"
+	"unicode/utf8"
+)
+
+// Span maps a letter of a stem or root back to the character of the original word it comes from.
+type Span struct {
+	Letter rune
+	// Start and End are the byte offsets, in the original word, of the character and the diacritics following it.
+	// They are -1 for a letter that isn't in the word, such as a weak letter restored in a root.
+	Start int
+	End   int
+}
+
+// alignedLetter is a letter of the prepared, unvocalized word with the bytes of the original word it comes from.
+type alignedLetter struct {
+	letter     rune
+	start, end int
+	// geminated is set when the letter carries a shadda.
+	geminated bool
+}
+
+// Align maps each letter of the light stem of the word to its position in the original word,
+// which may be vocalized. Removed diacritics and invisible characters are included in the span of the
+// letter they follow, and both letters of an expanded LAM ALEF ligature map to the ligature.
+// It returns nil for Arabizi words converted to Arabic script, as their letters can't be aligned.
+func (als *ArabicLightStemmer) Align(word string) []Span {
+	letters, ok := als.alignedLetters(word)
+	if !ok {
+		return nil
+	}
+	segmentation := als.StemSegmentation(word)
+	return alignLetters(segmentation.Stem, letters, stemOffset(letters, segmentation), func(a, b rune) bool {
+		return a == b
+	})
+}
+
+// AlignRoot maps each letter of the root of the word to its position in the original word, like Align.
+// Hamzas match whatever their seat, an ALEF MADDA matches both its hamza and its alef, and a geminated
+// letter matches the letter carrying the shadda. Restored weak letters have no position.
+func (als *ArabicLightStemmer) AlignRoot(word string) []Span {
+	letters, ok := als.alignedLetters(word)
+	if !ok {
+		return nil
+	}
+	root := als.Root(word)
+	// Roots usually lie within the stem, but prefix letters may be part of them, e.g. the WAW of ولد
+	fromStem := alignLetters(root, letters, stemOffset(letters, als.StemSegmentation(word)), sameRootLetter)
+	fromStart := alignLetters(root, letters, 0, sameRootLetter)
+	if unaligned(fromStart) < unaligned(fromStem) {
+		return fromStart
+	}
+	return fromStem
+}
+
+// alignedLetters normalizes the word letter by letter as the stemmer does, keeping track of the original bytes.
+func (als *ArabicLightStemmer) alignedLetters(word string) ([]alignedLetter, bool) {
+	if als.convertArabizi && als.arabiziConverter.IsArabizi(word) {
+		return nil, false
+	}
+	var letters []alignedLetter
+	for start, char := range word {
+		end := start + utf8.RuneLen(char)
+		normalized := normalize.StripTashkeel(als.normalizeLetters(string(char)))
+		if normalized == "" {
+			// Diacritics and invisible characters belong to the letter they follow
+			if len(letters) > 0 {
+				letters[len(letters)-1].end = end
+				letters[len(letters)-1].geminated = letters[len(letters)-1].geminated || string(char) == constant.SHADDA
+			}
+			continue
+		}
+		for _, letter := range normalized {
+			letters = append(letters, alignedLetter{letter: letter, start: start, end: end})
+		}
+	}
+	// Letters sharing a character, such as an expanded ligature, share its diacritics too
+	for i := len(letters) - 2; i >= 0; i-- {
+		if letters[i].start == letters[i+1].start {
+			letters[i].end = letters[i+1].end
+			letters[i].geminated = letters[i+1].geminated
+		}
+	}
+	return letters, true
+}
+
+// stemOffset returns the index of the first letter of the stem in the letters,
+// according to the segmentation when it matches them, and otherwise to the first occurrence of the stem.
+func stemOffset(letters []alignedLetter, segmentation Segmentation) int {
+	word := make([]rune, len(letters))
+	for i, letter := range letters {
+		word[i] = letter.letter
+	}
+	unvocalized := string(word)
+	if segmentation.Prefix+segmentation.Stem+segmentation.Suffix == unvocalized {
+		return utf8.RuneCountInString(segmentation.Prefix)
+	}
+	if i := strings.Index(unvocalized, segmentation.Stem); i >= 0 {
+		return utf8.RuneCountInString(unvocalized[:i])
+	}
+	return 0
+}
+
+// alignLetters matches the letters of the text, in order, with the letters of the word from the offset on.
+// Letters without a match get no position, and diacritics of the text take the position of the letter before them.
+func alignLetters(text string, letters []alignedLetter, offset int, same func(letter, char rune) bool) []Span {
+	var spans []Span
+	next := offset
+	for _, letter := range text {
+		span := Span{Letter: letter, Start: -1, End: -1}
+		switch {
+		case chars.IsTashkeel(letter):
+			if len(spans) > 0 {
+				span.Start, span.End = spans[len(spans)-1].Start, spans[len(spans)-1].End
+			}
+		case next > offset && reusable(letters[next-1]) && same(letter, letters[next-1].letter):
+			// The second letter of a geminated pair is written with a shadda on the first one,
+			// and an ALEF MADDA stands for both a hamza and an alef
+			span.Start, span.End = letters[next-1].start, letters[next-1].end
+		default:
+			for i := next; i < len(letters); i++ {
+				if same(letter, letters[i].letter) {
+					span.Start, span.End = letters[i].start, letters[i].end
+					next = i + 1
+					break
+				}
+			}
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+// sameRootLetter reports whether a root letter may come from the given letter of the word.
+func sameRootLetter(letter, char rune) bool {
+	if letter == char {
+		return true
+	}
+	switch string(char) {
+	case constant.ALEF_MADDA:
+		return string(letter) == constant.HAMZA || string(letter) == constant.ALEF
+	case constant.ALEF_MAKSURA:
+		return string(letter) == constant.YEH
+	}
+	return normalize.CollapseHamza(string(char)) == string(letter)
+}
+
+// reusable reports whether the letter of the word may match two consecutive letters of a root.
+func reusable(letter alignedLetter) bool {
+	return letter.geminated || string(letter.letter) == constant.ALEF_MADDA
+}
+
+// unaligned returns the number of spans without a position.
+func unaligned(spans []Span) int {
+	count := 0
+	for _, span := range spans {
+		if span.Start < 0 {
+			count++
+		}
+	}
+	return count
+}
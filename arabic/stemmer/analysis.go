@@ -0,0 +1,106 @@
+package stemmer
+
+// PartOfSpeech is the part of speech guessed for a reading of a word.
+type PartOfSpeech int
+
+const (
+	POSNoun PartOfSpeech = iota
+	POSVerb
+	// POSFunctionWord is the part of speech of stopwords, such as particles and pronouns.
+	POSFunctionWord
+)
+
+// Analysis is a reading of a word: a segmentation, the template matched by its stem and the root it yields,
+// with the part of speech its affixes allow.
+type Analysis struct {
+	Prefix string
+	Stem   string
+	Suffix string
+	// Pattern is the template (wazn) matched by the stem, written with the FEH/AIN/LAM placeholders.
+	// It is empty when no template yields a known root.
+	Pattern string
+	Root    string
+	POS     PartOfSpeech
+	// Comparative is set for the noun reading of a comparative adjective, e.g. أكبر, see AnalyzeComparative.
+	Comparative bool
+	// Diminutive is set for the noun reading of a diminutive, e.g. كتيب, see AnalyzeDiminutive.
+	Diminutive bool
+}
+
+// Analyze returns every consistent reading of the word, rather than the single one chosen by LightStem and Root.
+// Each segmentation given by SegmentAll yields a reading per part of speech its affixes are valid for, and per
+// template matching its stem with a root found in the roots dictionary, or else with the root extracted from
+// the segmentation if it's found there. Only when no reading has a known root are the segmentations returned
+// with their extracted roots. Readings follow the order of SegmentAll, verbs before nouns.
+// A stopword yields a single function word reading. A comparative or a diminutive yields its reading first,
+// with its pattern, which replaces the noun readings of the same stem or splitting the stem differently.
+func (als *ArabicLightStemmer) Analyze(word string) []Analysis {
+	if als.IsStopword(word) {
+		return []Analysis{{Stem: als.LightStem(word), Root: als.Root(word), POS: POSFunctionWord}}
+	}
+	var known, unknown []Analysis
+	seen := make(map[Analysis]bool)
+	derived, isDerived := als.derivedNounReading(word)
+	if isDerived {
+		seen[derived] = true
+		known = append(known, derived)
+	}
+	for _, segmentation := range als.SegmentAll(word) {
+		for _, pos := range segmentationPOS(segmentation) {
+			if isDerived && pos == POSNoun && (segmentation.Prefix != derived.Prefix || segmentation.Stem == derived.Stem) {
+				continue
+			}
+			reading := Analysis{Prefix: segmentation.Prefix, Stem: segmentation.Stem, Suffix: segmentation.Suffix, POS: pos}
+			matched := false
+			for _, p := range als.patternMatcher.Match(segmentation.Stem) {
+				reading.Pattern, reading.Root = p.Name, als.rootsManager.NormalizeRoot(p.Root)
+				if als.rootsManager.IsRoot(reading.Root) && !seen[reading] {
+					seen[reading] = true
+					known = append(known, reading)
+					matched = true
+				}
+			}
+			if matched {
+				continue
+			}
+			reading.Pattern, reading.Root = "", segmentation.Root
+			if seen[reading] {
+				continue
+			}
+			seen[reading] = true
+			if als.rootsManager.IsRoot(reading.Root) {
+				known = append(known, reading)
+			} else {
+				unknown = append(unknown, reading)
+			}
+		}
+	}
+	if len(known) > 0 {
+		return known
+	}
+	return unknown
+}
+
+// derivedNounReading returns the reading of the word as a comparative or a diminutive, whose stems the
+// segmentations split wrongly.
+func (als *ArabicLightStemmer) derivedNounReading(word string) (Analysis, bool) {
+	if c, ok := als.AnalyzeComparative(word); ok {
+		return Analysis{Prefix: c.Prefix, Stem: c.Stem, Suffix: c.Suffix, Pattern: comparativePattern, Root: c.Root, POS: POSNoun, Comparative: true}, true
+	}
+	if d, ok := als.AnalyzeDiminutive(word); ok {
+		return Analysis{Prefix: d.Prefix, Stem: d.Stem, Suffix: d.Suffix, Pattern: d.Pattern, Root: d.Root, POS: POSNoun, Diminutive: true}, true
+	}
+	return Analysis{}, false
+}
+
+// segmentationPOS returns the parts of speech the affixes of the segmentation are valid for, verbs first.
+func segmentationPOS(segmentation Segmentation) []PartOfSpeech {
+	var parts []PartOfSpeech
+	if segmentation.Verb {
+		parts = append(parts, POSVerb)
+	}
+	if segmentation.Noun {
+		parts = append(parts, POSNoun)
+	}
+	return parts
+}
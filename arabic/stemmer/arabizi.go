@@ -0,0 +1,10 @@
+package stemmer
+
+// FromArabizi converts a word written in Arabizi (romanized Arabic, e.g. "7abibi") to Arabic script.
+// Words that aren't Arabizi are returned unchanged.
+func (als *ArabicLightStemmer) FromArabizi(word string) string {
+	if !als.arabiziConverter.IsArabizi(word) {
+		return word
+	}
+	return als.arabiziConverter.Convert(word)
+}
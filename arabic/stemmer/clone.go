@@ -0,0 +1,48 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"sync/atomic"
+)
+
+// Clone returns a copy of the stemmer that can be customized independently, e.g. one per tenant of a service.
+// The large read-only data (roots dictionary, stopwords, verb stamps, affix trees) is shared rather than copied,
+// while the configuration (letters, lengths, lists, options, protected words and the expansion index) is copied,
+// so setters called on the clone never affect the original, and vice versa. Setters replacing a shared
+// dictionary, such as SetRootsList, build a new one for the stemmer they are called on. Reload, however,
+// updates the shared stopwords and roots of every stemmer sharing them.
+func (als *ArabicLightStemmer) Clone() *ArabicLightStemmer {
+	clone := *als
+	clone.affixes = new(atomic.Pointer[affixSet])
+	clone.affixes.Store(als.affixes.Load())
+
+	clone.protectedWords = als.protectedWords.Clone()
+	clone.expansionIndex = make(map[string][]string, len(als.expansionIndex))
+	for root, forms := range als.expansionIndex {
+		clone.expansionIndex[root] = append([]string{}, forms...)
+	}
+	return &clone
+}
+
+// SetProtectedWords sets the words that are never stemmed: LightStem and Root return them unchanged,
+// apart from the removal of tashkeel. It is meant for names and domain terms the affix rules would damage.
+func (als *ArabicLightStemmer) SetProtectedWords(words []string) {
+	als.protectedWords = utils.NewSet[string]()
+	for _, word := range words {
+		als.protectedWords.Add(als.wordProcessor.StripTashkeel(word))
+	}
+}
+
+// GetProtectedWords returns the words that are never stemmed, without tashkeel and in no particular order.
+func (als *ArabicLightStemmer) GetProtectedWords() []string {
+	return als.protectedWords.Values()
+}
+
+// protectedWord returns the unvocalized word and true if it is a protected word.
+func (als *ArabicLightStemmer) protectedWord(word string) (string, bool) {
+	if als.protectedWords.Len() == 0 {
+		return "", false
+	}
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	return unvocalized, als.protectedWords.Has(unvocalized)
+}
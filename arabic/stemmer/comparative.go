@@ -0,0 +1,40 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/comparative"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+)
+
+// comparativePattern is the template of comparatives, reported by Analyze.
+const comparativePattern = constant.ALEF_HAMZA_ABOVE + constant.FEH + constant.AIN + constant.LAM
+
+// AnalyzeComparative checks whether the given word is a comparative adjective (أفعل التفضيل), e.g. الأكبر or أقلهم,
+// and reports its stem and root. Stopwords are not considered comparatives.
+func (als *ArabicLightStemmer) AnalyzeComparative(word string) (comparative.Comparative, bool) {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	if als.stopWordManager.IsStopword(unvocalized) {
+		return comparative.Comparative{}, false
+	}
+	return als.comparativeAnalyzer.Analyze(unvocalized)
+}
+
+// SetStripComparativeHamza enables or disables stripping the leading hamza-alef of comparatives as a prefix.
+// By default comparatives keep it in their stem, e.g. أكبرهم → أكبر, while their root is found from the pattern,
+// e.g. أقل → قلل.
+func (als *ArabicLightStemmer) SetStripComparativeHamza(stripComparativeHamza bool) {
+	als.stripComparativeHamza = stripComparativeHamza
+}
+
+// GetStripComparativeHamza returns whether the leading hamza-alef of comparatives is stripped as a prefix.
+func (als *ArabicLightStemmer) GetStripComparativeHamza() bool {
+	return als.stripComparativeHamza
+}
+
+// comparativeStem returns the stem and root of a prepared word when it is a comparative kept whole.
+func (als *ArabicLightStemmer) comparativeStem(word string) (stem, root string, ok bool) {
+	if als.stripComparativeHamza {
+		return "", "", false
+	}
+	c, ok := als.AnalyzeComparative(word)
+	return c.Stem, c.Root, ok
+}
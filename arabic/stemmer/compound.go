@@ -0,0 +1,26 @@
+package stemmer
+
+// Compound is a token of words joined without spaces, e.g. عربي/إنجليزي, with the light stem of each word.
+type Compound struct {
+	// Text is the token as written.
+	Text string `json:"text"`
+	// Parts are the words of the token, and Joiners the characters joining each one to the next.
+	Parts   []string `json:"parts"`
+	Joiners []string `json:"joiners"`
+	// Stems holds the light stem of each part, in the same order.
+	Stems []string `json:"stems"`
+}
+
+// StemCompounds splits the text into tokens, keeping the words joined by hyphens, slashes, Arabic commas
+// or kashidas together, and stems each of their words. Plain words are returned as compounds of one part.
+func (als *ArabicLightStemmer) StemCompounds(text string) []Compound {
+	var compounds []Compound
+	for _, token := range als.tokenizer.TokenizeCompounds(text) {
+		compound := Compound{Text: token.Text, Parts: token.Parts, Joiners: token.Joiners}
+		for _, part := range token.Parts {
+			compound.Stems = append(compound.Stems, als.LightStem(part))
+		}
+		compounds = append(compounds, compound)
+	}
+	return compounds
+}
@@ -0,0 +1,57 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/tokenizer"
+	"strings"
+)
+
+// KWICLine is an occurrence of a keyword in a text with its context, a line of a keyword-in-context concordance.
+type KWICLine struct {
+	// Left and Right are the text surrounding the keyword, punctuation included, up to the window of words.
+	Left    string `json:"left"`
+	Keyword string `json:"keyword"`
+	Right   string `json:"right"`
+	// Position is the index of the keyword among the words of the text.
+	Position int `json:"position"`
+}
+
+// Concordance finds the words of the text whose light stem or root is the key, compared without tashkeel,
+// and returns each of them with the window words preceding and following it, in text order.
+// The context is taken from the text as written, apart from invisible characters, so it keeps its punctuation.
+func (als *ArabicLightStemmer) Concordance(text, key string, window int) []KWICLine {
+	key = normalize.StripTashkeel(key)
+	if key == "" {
+		return nil
+	}
+	text = normalize.StripInvisible(text)
+	var words []tokenizer.Token
+	for _, token := range als.tokenizer.TokenizeTyped(text) {
+		if token.Type == tokenizer.ArabicWord || token.Type == tokenizer.LatinWord {
+			words = append(words, token)
+		}
+	}
+
+	window = max(window, 0)
+	var lines []KWICLine
+	matches := make(map[string]bool)
+	for i, word := range words {
+		matched, exists := matches[word.Text]
+		if !exists {
+			matched = als.LightStem(word.Text) == key || als.Root(word.Text) == key
+			matches[word.Text] = matched
+		}
+		if !matched {
+			continue
+		}
+		first, last := words[max(i-window, 0)], words[min(i+window, len(words)-1)]
+		end := word.Offset + len(word.Text)
+		lines = append(lines, KWICLine{
+			Left:     strings.TrimSpace(text[first.Offset:word.Offset]),
+			Keyword:  word.Text,
+			Right:    strings.TrimSpace(text[end : last.Offset+len(last.Text)]),
+			Position: i,
+		})
+	}
+	return lines
+}
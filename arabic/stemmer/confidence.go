@@ -0,0 +1,88 @@
+package stemmer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Weights of the evidence making up the confidence of a stem. They sum to 1.
+const (
+	rootConfidence      = 0.4
+	affixConfidence     = 0.3
+	agreementConfidence = 0.3
+)
+
+// SetFallbackOriginal makes LightStem return the input word unchanged when the confidence of its stem
+// is below the threshold, since precision-sensitive applications prefer no stemming to wrong stemming.
+// A threshold of 0 disables the fallback. It returns ErrInvalidOption if the threshold isn't between 0 and 1.
+func (als *ArabicLightStemmer) SetFallbackOriginal(threshold float64) error {
+	if threshold < 0 || threshold > 1 {
+		return fmt.Errorf("%w: fallback threshold %g", ErrInvalidOption, threshold)
+	}
+	als.fallbackOriginal = threshold
+	return nil
+}
+
+// GetFallbackOriginal returns the confidence below which LightStem returns the input word unchanged.
+// The default is 0, which never falls back.
+func (als *ArabicLightStemmer) GetFallbackOriginal() float64 {
+	return als.fallbackOriginal
+}
+
+// StemWithConfidence returns the light stem of the word along with its confidence, between 0 and 1.
+// The confidence is that of the stem found before any fallback to the original word.
+func (als *ArabicLightStemmer) StemWithConfidence(word string) (string, float64) {
+	stem := als.lightStem(word)
+	confidence := als.stemConfidence(word, stem)
+	if confidence < als.fallbackOriginal {
+		return word, confidence
+	}
+	return stem, confidence
+}
+
+// Confidence returns how reliable the light stem of the word is, between 0 and 1. It adds up evidence that
+// the root of the word is in the roots dictionary, that the stem results from valid affixes, and that
+// the valid segmentations of the word agree on its root. Stopwords and protected words are fully confident.
+func (als *ArabicLightStemmer) Confidence(word string) float64 {
+	return als.stemConfidence(word, als.lightStem(word))
+}
+
+// stemConfidence returns the confidence of the stem found for the word. Words that aren't segmented,
+// such as stopwords, protected words, skipped loanwords and hashtags, are fully confident.
+func (als *ArabicLightStemmer) stemConfidence(word, stem string) float64 {
+	if word == "" || als.luceneCompatible || als.engine != EngineLight || (als.hashtagAware && strings.HasPrefix(word, "#")) {
+		return 1
+	}
+	prepared := als.prepareWord(word)
+	if _, ok := als.protectedWord(prepared); ok {
+		return 1
+	}
+	if _, _, ok := als.shortWord(prepared); ok {
+		return 1
+	}
+	if _, ok := als.skipLoanword(prepared); ok {
+		return 1
+	}
+	if als.IsStopword(prepared) {
+		return 1
+	}
+
+	confidence := 0.0
+	if als.rootsManager.IsRoot(als.Root(word)) {
+		confidence += rootConfidence
+	}
+	segmentations := als.SegmentAll(word)
+	for _, chosen := range segmentations {
+		if chosen.Stem != stem {
+			continue
+		}
+		agreeing := 0
+		for _, segmentation := range segmentations {
+			if segmentation.Root == chosen.Root {
+				agreeing++
+			}
+		}
+		return confidence + affixConfidence + agreementConfidence*float64(agreeing)/float64(len(segmentations))
+	}
+	return confidence
+}
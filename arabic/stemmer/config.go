@@ -0,0 +1,301 @@
+package stemmer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/geminate"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/plural"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stamp"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/tokenizer"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/weak"
+	"strings"
+	"unicode/utf8"
+)
+
+// Config holds every tunable of the stemmer in a plain struct, so that a configuration can be loaded from an
+// application config file, reviewed in code and validated before use. Start from DefaultConfig and change the
+// fields you need: unmarshaling a file into the default configuration keeps the defaults of the keys it omits.
+type Config struct {
+	PrefixLetters   string `json:"prefix_letters" yaml:"prefix_letters"`
+	SuffixLetters   string `json:"suffix_letters" yaml:"suffix_letters"`
+	InfixLetters    string `json:"infix_letters" yaml:"infix_letters"`
+	Joker           string `json:"joker" yaml:"joker"`
+	MaxPrefixLength int    `json:"max_prefix_length" yaml:"max_prefix_length"`
+	MaxSuffixLength int    `json:"max_suffix_length" yaml:"max_suffix_length"`
+	MinStemLength   int    `json:"min_stem_length" yaml:"min_stem_length"`
+
+	PrefixList       []string          `json:"prefix_list" yaml:"prefix_list"`
+	SuffixList       []string          `json:"suffix_list" yaml:"suffix_list"`
+	ValidAffixesList []string          `json:"valid_affixes_list" yaml:"valid_affixes_list"`
+	AffixRules       []affix.Rule      `json:"affix_rules" yaml:"affix_rules"`
+	RootsList        []string          `json:"roots_list" yaml:"roots_list"`
+	VerbList         []string          `json:"verb_list" yaml:"verb_list"`
+	PatternList      []string          `json:"pattern_list" yaml:"pattern_list"`
+	ProtectedWords   []string          `json:"protected_words" yaml:"protected_words"`
+	StopPhrases      []string          `json:"stop_phrases" yaml:"stop_phrases"`
+	Expressions      map[string]string `json:"expressions" yaml:"expressions"`
+
+	StripNisba            bool                        `json:"strip_nisba" yaml:"strip_nisba"`
+	StripComparativeHamza bool                        `json:"strip_comparative_hamza" yaml:"strip_comparative_hamza"`
+	RestoreHamza          bool                        `json:"restore_hamza" yaml:"restore_hamza"`
+	SkipLoanwords         bool                        `json:"skip_loanwords" yaml:"skip_loanwords"`
+	ConvertArabizi        bool                        `json:"convert_arabizi" yaml:"convert_arabizi"`
+	SpellingTolerant      bool                        `json:"spelling_tolerant" yaml:"spelling_tolerant"`
+	LuceneCompatible      bool                        `json:"lucene_compatible" yaml:"lucene_compatible"`
+	HashtagAware          bool                        `json:"hashtag_aware" yaml:"hashtag_aware"`
+	AlefWasla             normalize.AlefTreatment     `json:"alef_wasla" yaml:"alef_wasla"`
+	DaggerAlef            normalize.AlefTreatment     `json:"dagger_alef" yaml:"dagger_alef"`
+	TehMarbuta            normalize.TehMarbutaPolicy  `json:"teh_marbuta" yaml:"teh_marbuta"`
+	AlefMaksura           normalize.AlefMaksuraPolicy `json:"alef_maksura" yaml:"alef_maksura"`
+	HamzaLevel            normalize.HamzaLevel        `json:"hamza_level" yaml:"hamza_level"`
+	Segmentation          SegmentationStrategy        `json:"segmentation" yaml:"segmentation"`
+	Engine                Engine                      `json:"engine" yaml:"engine"`
+	AffixWeights          affix.Weights               `json:"affix_weights" yaml:"affix_weights"`
+	FallbackOriginal      float64                     `json:"fallback_original" yaml:"fallback_original"`
+	ShortWordPolicy       ShortWordPolicy             `json:"short_word_policy" yaml:"short_word_policy"`
+	ShortWordLength       int                         `json:"short_word_length" yaml:"short_word_length"`
+	FeminineBase          plural.FeminineBase         `json:"feminine_base" yaml:"feminine_base"`
+
+	WeakRootPolicy      weak.Policy               `json:"weak_root_policy" yaml:"weak_root_policy"`
+	GeminationRules     geminate.Rules            `json:"gemination_rules" yaml:"gemination_rules"`
+	QuadriliteralPolicy roots.QuadriliteralPolicy `json:"quadriliteral_policy" yaml:"quadriliteral_policy"`
+	RootPolicy          roots.RootPolicy          `json:"root_policy" yaml:"root_policy"`
+
+	TokenPolicies map[tokenizer.TokenType]TokenPolicy `json:"token_policies" yaml:"token_policies"`
+}
+
+// DefaultConfig returns the configuration of a stemmer created with NewArabicLightStemmer.
+// The lists are copies, so they can be modified without affecting the package defaults.
+func DefaultConfig() Config {
+	validAffixes := append([]string{}, constant.NOUN_AFFIX_LIST...)
+	validAffixes = append(validAffixes, constant.VERB_AFFIX_LIST...)
+	return Config{
+		PrefixLetters:       constant.DEFAULT_PREFIX_LETTERS,
+		SuffixLetters:       constant.DEFAULT_SUFFIX_LETTERS,
+		InfixLetters:        constant.DEFAULT_INFIX_LETTERS,
+		Joker:               constant.DEFAULT_JOKER,
+		MaxPrefixLength:     constant.DEFAULT_MAX_PREFIX,
+		MaxSuffixLength:     constant.DEFAULT_MAX_SUFFIX,
+		MinStemLength:       constant.DEFAULT_MIN_STEM,
+		ShortWordLength:     constant.DEFAULT_SHORT_WORD,
+		PrefixList:          append([]string{}, constant.DEFAULT_PREFIX_LIST...),
+		SuffixList:          append([]string{}, constant.DEFAULT_SUFFIX_LIST...),
+		ValidAffixesList:    validAffixes,
+		AffixRules:          affix.DefaultRules(),
+		AffixWeights:        affix.DefaultWeights(),
+		RootsList:           append([]string{}, constant.Roots()...),
+		VerbList:            append([]string{}, stamp.INITIAL_VERB_LIST...),
+		PatternList:         append([]string{}, constant.DEFAULT_PATTERN_LIST...),
+		StopPhrases:         append([]string{}, constant.DEFAULT_STOP_PHRASES...),
+		AlefWasla:           normalize.AlefToPlain,
+		DaggerAlef:          normalize.AlefStrip,
+		HamzaLevel:          normalize.HamzaFull,
+		WeakRootPolicy:      weak.DefaultPolicy(),
+		GeminationRules:     geminate.DefaultRules(),
+		QuadriliteralPolicy: roots.QuadriliteralAllow,
+		RootPolicy:          roots.DefaultRootPolicy(),
+		TokenPolicies:       DefaultTokenPolicies(),
+	}
+}
+
+// Validate checks the configuration and returns every problem found, joined with errors.Join.
+// Each problem wraps one of the package errors, such as ErrInvalidJoker or ErrEmptyAffixList.
+func (c Config) Validate() error {
+	var errs []error
+	if c.PrefixLetters == "" {
+		errs = append(errs, fmt.Errorf("%w: prefix letters", ErrEmptyLetters))
+	}
+	if c.SuffixLetters == "" {
+		errs = append(errs, fmt.Errorf("%w: suffix letters", ErrEmptyLetters))
+	}
+	if utf8.RuneCountInString(c.Joker) != 1 {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidJoker, c.Joker))
+	} else if strings.Contains(c.PrefixLetters+c.SuffixLetters+c.InfixLetters, c.Joker) {
+		errs = append(errs, fmt.Errorf("%w: %q is also an affix letter", ErrInvalidJoker, c.Joker))
+	}
+	for _, length := range []struct {
+		name  string
+		value int
+	}{
+		{"maximum prefix length", c.MaxPrefixLength},
+		{"maximum suffix length", c.MaxSuffixLength},
+		{"minimum stem length", c.MinStemLength},
+		{"short word length", c.ShortWordLength},
+	} {
+		if length.value < 1 {
+			errs = append(errs, fmt.Errorf("%w: %s %d", ErrInvalidLength, length.name, length.value))
+		}
+	}
+	if len(c.PrefixList) == 0 {
+		errs = append(errs, fmt.Errorf("%w: prefix list", ErrEmptyAffixList))
+	}
+	if len(c.SuffixList) == 0 {
+		errs = append(errs, fmt.Errorf("%w: suffix list", ErrEmptyAffixList))
+	}
+	if len(c.AffixRules) == 0 {
+		errs = append(errs, fmt.Errorf("%w: affix rules", ErrEmptyAffixList))
+	}
+	for _, rule := range c.AffixRules {
+		if rule.Tag != affix.TagVerb && rule.Tag != affix.TagNoun {
+			errs = append(errs, fmt.Errorf("%w: affix rule %s-%s has tag %q", ErrInvalidOption, rule.Prefix, rule.Suffix, rule.Tag))
+			break
+		}
+	}
+	if c.FallbackOriginal < 0 || c.FallbackOriginal > 1 {
+		errs = append(errs, fmt.Errorf("%w: fallback threshold %g", ErrInvalidOption, c.FallbackOriginal))
+	}
+	if c.ShortWordPolicy < ShortWordStem || c.ShortWordPolicy > ShortWordStopwordOnly {
+		errs = append(errs, fmt.Errorf("%w: short word policy %d", ErrInvalidOption, c.ShortWordPolicy))
+	}
+	if c.FeminineBase < plural.FeminineBaseSingular || c.FeminineBase > plural.FeminineBaseKeep {
+		errs = append(errs, fmt.Errorf("%w: feminine base %d", ErrInvalidOption, c.FeminineBase))
+	}
+	if c.TehMarbuta < normalize.TehMarbutaStrip || c.TehMarbuta > normalize.TehMarbutaKeep {
+		errs = append(errs, fmt.Errorf("%w: teh marbuta policy %d", ErrInvalidOption, c.TehMarbuta))
+	}
+	if c.AlefMaksura < normalize.AlefMaksuraToYeh || c.AlefMaksura > normalize.YehToAlefMaksura {
+		errs = append(errs, fmt.Errorf("%w: alef maksura policy %d", ErrInvalidOption, c.AlefMaksura))
+	}
+	if c.HamzaLevel < normalize.HamzaNone || c.HamzaLevel > normalize.HamzaFull {
+		errs = append(errs, fmt.Errorf("%w: hamza level %d", ErrInvalidOption, c.HamzaLevel))
+	}
+	if len(c.RootsList) == 0 {
+		errs = append(errs, ErrEmptyRootsList)
+	}
+	if len(c.VerbList) == 0 {
+		errs = append(errs, ErrEmptyVerbList)
+	}
+	if c.AlefWasla < normalize.AlefToPlain || c.AlefWasla > normalize.AlefStrip {
+		errs = append(errs, fmt.Errorf("%w: alef wasla treatment %d", ErrInvalidOption, c.AlefWasla))
+	}
+	if c.DaggerAlef < normalize.AlefToPlain || c.DaggerAlef > normalize.AlefStrip {
+		errs = append(errs, fmt.Errorf("%w: dagger alef treatment %d", ErrInvalidOption, c.DaggerAlef))
+	}
+	if c.Segmentation < StrategyMaxPrefixMinSuffix || c.Segmentation > StrategyWeighted {
+		errs = append(errs, fmt.Errorf("%w: segmentation strategy %d", ErrInvalidOption, c.Segmentation))
+	}
+	if c.Engine < EngineLight || c.Engine > EngineLight10Extended {
+		errs = append(errs, fmt.Errorf("%w: engine %d", ErrInvalidOption, c.Engine))
+	}
+	if c.QuadriliteralPolicy < roots.QuadriliteralAllow || c.QuadriliteralPolicy > roots.QuadriliteralDeny {
+		errs = append(errs, fmt.Errorf("%w: quadriliteral policy %d", ErrInvalidOption, c.QuadriliteralPolicy))
+	}
+	if c.RootPolicy.MinLength < 1 || c.RootPolicy.MaxLength < c.RootPolicy.MinLength {
+		errs = append(errs, fmt.Errorf("%w: root lengths %d to %d", ErrInvalidOption, c.RootPolicy.MinLength, c.RootPolicy.MaxLength))
+	}
+	for tokenType, policy := range c.TokenPolicies {
+		if err := validTokenPolicy(tokenType, policy); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewFromConfig creates a stemmer with the given configuration, after validating it.
+// It returns the validation errors, and no stemmer, if the configuration is invalid.
+func NewFromConfig(cfg Config) (*ArabicLightStemmer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	als := NewArabicLightStemmer()
+	// The configuration is valid, so the setters can't fail
+	als.SetPrefixLetters(cfg.PrefixLetters)
+	als.SetSuffixLetters(cfg.SuffixLetters)
+	als.SetInfixLetters(cfg.InfixLetters)
+	als.SetJoker(cfg.Joker)
+	als.SetMaxPrefixLength(cfg.MaxPrefixLength)
+	als.SetMaxSuffixLength(cfg.MaxSuffixLength)
+	als.SetMinStemLength(cfg.MinStemLength)
+	als.SetPrefixList(append([]string{}, cfg.PrefixList...))
+	als.SetSuffixList(append([]string{}, cfg.SuffixList...))
+	als.SetValidAffixesList(append([]string{}, cfg.ValidAffixesList...))
+	als.SetAffixRules(cfg.AffixRules)
+	als.SetRootsList(append([]string{}, cfg.RootsList...))
+	als.SetVerbList(cfg.VerbList)
+	als.SetPatternList(cfg.PatternList)
+	als.SetProtectedWords(cfg.ProtectedWords)
+	als.SetStopPhrases(cfg.StopPhrases)
+	als.SetExpressions(cfg.Expressions)
+	als.SetStripNisba(cfg.StripNisba)
+	als.SetStripComparativeHamza(cfg.StripComparativeHamza)
+	als.SetRestoreHamza(cfg.RestoreHamza)
+	als.SetSkipLoanwords(cfg.SkipLoanwords)
+	als.SetConvertArabizi(cfg.ConvertArabizi)
+	als.SetSpellingTolerant(cfg.SpellingTolerant)
+	als.SetLuceneCompatible(cfg.LuceneCompatible)
+	als.SetHashtagAware(cfg.HashtagAware)
+	als.SetAlefWasla(cfg.AlefWasla)
+	als.SetDaggerAlef(cfg.DaggerAlef)
+	als.SetTehMarbuta(cfg.TehMarbuta)
+	als.SetAlefMaksura(cfg.AlefMaksura)
+	als.SetHamzaLevel(cfg.HamzaLevel)
+	als.SetSegmentationStrategy(cfg.Segmentation)
+	als.SetEngine(cfg.Engine)
+	als.SetAffixWeights(cfg.AffixWeights)
+	als.SetFallbackOriginal(cfg.FallbackOriginal)
+	als.SetShortWordPolicy(cfg.ShortWordPolicy)
+	als.SetShortWordLength(cfg.ShortWordLength)
+	als.SetFeminineBase(cfg.FeminineBase)
+	als.SetWeakRootPolicy(cfg.WeakRootPolicy)
+	als.SetGeminationRules(cfg.GeminationRules)
+	als.SetQuadriliteralPolicy(cfg.QuadriliteralPolicy)
+	als.SetRootPolicy(cfg.RootPolicy)
+	for tokenType, policy := range cfg.TokenPolicies {
+		als.SetTokenPolicy(tokenType, policy)
+	}
+	return als, nil
+}
+
+// Config returns the current configuration of the stemmer, reflecting every change made through the setters.
+// Passing it to NewFromConfig creates a stemmer configured the same way.
+func (als *ArabicLightStemmer) Config() Config {
+	affixes := als.affixes.Load()
+	return Config{
+		PrefixLetters:         als.prefixLetters,
+		SuffixLetters:         als.suffixLetters,
+		InfixLetters:          als.infixLetters,
+		Joker:                 als.joker,
+		MaxPrefixLength:       als.maxPrefixLength,
+		MaxSuffixLength:       als.maxSuffixLength,
+		MinStemLength:         als.minStemLength,
+		PrefixList:            append([]string{}, affixes.prefixList...),
+		SuffixList:            append([]string{}, affixes.suffixList...),
+		ValidAffixesList:      append([]string{}, als.validAffixesList...),
+		AffixRules:            als.GetAffixRules(),
+		RootsList:             append([]string{}, als.rootsManager.Roots()...),
+		VerbList:              als.verbListManager.Stamps(),
+		PatternList:           als.patternMatcher.Templates(),
+		ProtectedWords:        als.GetProtectedWords(),
+		StopPhrases:           als.GetStopPhrases(),
+		Expressions:           als.GetExpressions(),
+		StripNisba:            als.stripNisba,
+		StripComparativeHamza: als.stripComparativeHamza,
+		RestoreHamza:          als.restoreHamza,
+		SkipLoanwords:         als.skipLoanwords,
+		ConvertArabizi:        als.convertArabizi,
+		SpellingTolerant:      als.spellingTolerant,
+		LuceneCompatible:      als.luceneCompatible,
+		HashtagAware:          als.hashtagAware,
+		AlefWasla:             als.alefWasla,
+		DaggerAlef:            als.daggerAlef,
+		TehMarbuta:            als.tehMarbuta,
+		AlefMaksura:           als.alefMaksura,
+		HamzaLevel:            als.hamzaLevel,
+		Segmentation:          als.segmentationStrategy,
+		Engine:                als.engine,
+		AffixWeights:          als.GetAffixWeights(),
+		FallbackOriginal:      als.fallbackOriginal,
+		ShortWordPolicy:       als.shortWordPolicy,
+		FeminineBase:          als.feminineBase,
+		ShortWordLength:       als.shortWordLength,
+		WeakRootPolicy:        als.weakRootResolver.Policy(),
+		GeminationRules:       als.geminationRules,
+		QuadriliteralPolicy:   als.quadPolicy,
+		RootPolicy:            als.rootsManager.Policy(),
+		TokenPolicies:         als.GetTokenPolicies(),
+	}
+}
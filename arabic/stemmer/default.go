@@ -0,0 +1,31 @@
+package stemmer
+
+import (
+	"sync"
+)
+
+var (
+	defaultStemmer *ArabicLightStemmer
+	defaultOnce    sync.Once
+)
+
+// Default returns the shared stemmer used by Stem and Root, creating it with the default configuration on first use.
+// It is meant to be read only: configure a separate stemmer, or a Clone of this one, instead of calling its setters.
+func Default() *ArabicLightStemmer {
+	defaultOnce.Do(func() {
+		defaultStemmer = NewArabicLightStemmer()
+	})
+	return defaultStemmer
+}
+
+// Stem returns the light stem of the word using the default stemmer.
+// It is a shorthand for scripts and tests that do not need to configure a stemmer.
+func Stem(word string) string {
+	return Default().LightStem(word)
+}
+
+// Root returns the root of the word using the default stemmer.
+// It is a shorthand for scripts and tests that do not need to configure a stemmer.
+func Root(word string) string {
+	return Default().Root(word)
+}
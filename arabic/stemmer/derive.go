@@ -0,0 +1,23 @@
+package stemmer
+
+// CanDeriveFrom checks if any valid segmentation of the word yields the given root, e.g. for query-time filtering
+// of the words deriving from كتب. A segmentation yields the root if the root is extracted from it, or if its stem
+// matches a morphological template around the root letters (كتاب on فعال). The root may be written with hamza seats
+// or tashkeel; it is normalized to the form of the roots dictionary before the comparison.
+func (als *ArabicLightStemmer) CanDeriveFrom(word, root string) bool {
+	root = als.normalizeRoot(als.wordProcessor.StripTashkeel(root))
+	if root == "" {
+		return false
+	}
+	for _, segmentation := range als.SegmentAll(word) {
+		if als.normalizeRoot(segmentation.Root) == root {
+			return true
+		}
+		for _, p := range als.patternMatcher.Match(segmentation.Stem) {
+			if als.normalizeRoot(p.Root) == root {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/diminutive"
+)
+
+// AnalyzeDiminutive checks whether the given word is a diminutive (تصغير), e.g. كُتَيِّب or الدريهمات,
+// and reports its stem, pattern and root. Stopwords are not considered diminutives.
+func (als *ArabicLightStemmer) AnalyzeDiminutive(word string) (diminutive.Diminutive, bool) {
+	if als.IsStopword(word) {
+		return diminutive.Diminutive{}, false
+	}
+	return als.diminutiveAnalyzer.Analyze(word)
+}
+
+// diminutiveStem returns the stem and root of a prepared word when it is a diminutive, whose infix YEH
+// would otherwise be taken for an affix letter, e.g. كتيب stemmed to تيب with the root كوب.
+func (als *ArabicLightStemmer) diminutiveStem(word string) (stem, root string, ok bool) {
+	d, ok := als.AnalyzeDiminutive(word)
+	return d.Stem, d.Root, ok
+}
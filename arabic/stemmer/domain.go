@@ -0,0 +1,124 @@
+package stemmer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/dictfile"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Domain names a specialized vocabulary, such as the terms of medical or legal texts, that the default
+// dictionaries mishandle.
+type Domain string
+
+// The domains for which bundles are expected. Their bundles ship separately and are registered with RegisterDomain.
+const (
+	DomainNews      Domain = "news"
+	DomainReligious Domain = "religious"
+	DomainMedical   Domain = "medical"
+	DomainLegal     Domain = "legal"
+)
+
+// DomainBundle holds the vocabulary of a domain, added to the default dictionaries by WithDomain.
+type DomainBundle struct {
+	// Roots are added to the roots dictionary.
+	Roots []string
+	// ProtectedWords are added to the words that are never stemmed, such as drug names or legal terms.
+	ProtectedWords []string
+	// Stopwords is a JSON document in the format of stop_words/stopwords.json, e.g. written by analysis.WriteStopwords.
+	// Its entries replace the default stopwords of the same words.
+	Stopwords []byte
+}
+
+// Files of a bundle directory read by LoadDomainBundle.
+const (
+	domainRootsFile          = "roots.txt"
+	domainProtectedWordsFile = "protected.txt"
+	domainStopwordsFile      = "stopwords.json"
+)
+
+var (
+	domainsMu sync.RWMutex
+	domains   = make(map[Domain]DomainBundle)
+)
+
+// RegisterDomain makes the bundle of a domain available to WithDomain, replacing any bundle registered before.
+// Packages shipping bundles usually call it from their init function.
+func RegisterDomain(domain Domain, bundle DomainBundle) {
+	domainsMu.Lock()
+	defer domainsMu.Unlock()
+	domains[domain] = bundle
+}
+
+// Domains returns the domains with a registered bundle, sorted by name.
+func Domains() []Domain {
+	domainsMu.RLock()
+	defer domainsMu.RUnlock()
+	registered := make([]Domain, 0, len(domains))
+	for domain := range domains {
+		registered = append(registered, domain)
+	}
+	sort.Slice(registered, func(i, j int) bool { return registered[i] < registered[j] })
+	return registered
+}
+
+// LoadDomainBundle reads a bundle from a directory holding any of roots.txt and protected.txt, with one entry
+// per line, and stopwords.json. Missing files are skipped, and versioned headers checked; errors wrap ErrDictionaryLoad.
+func LoadDomainBundle(dir string) (DomainBundle, error) {
+	var bundle DomainBundle
+	var err error
+	if bundle.Roots, err = readOptionalLines(filepath.Join(dir, domainRootsFile), dictfile.KindRoots); err != nil {
+		return DomainBundle{}, err
+	}
+	if bundle.ProtectedWords, err = readOptionalLines(filepath.Join(dir, domainProtectedWordsFile), dictfile.KindProtectedWords); err != nil {
+		return DomainBundle{}, err
+	}
+	path := filepath.Join(dir, domainStopwordsFile)
+	if bundle.Stopwords, err = dictfile.ReadFile(path, dictfile.KindStopwords); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return DomainBundle{}, fmt.Errorf("%w: %s: %w", ErrDictionaryLoad, path, err)
+	}
+	return bundle, nil
+}
+
+// readOptionalLines reads a file with one entry per line, returning no entries if the file doesn't exist.
+func readOptionalLines(path string, kind dictfile.Kind) ([]string, error) {
+	lines, err := readLines(path, kind)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return lines, err
+}
+
+// WithDomain returns a clone of the stemmer whose dictionaries also hold the vocabulary of the domain's bundle.
+// The stemmer itself is unchanged. It returns ErrUnknownDomain if no bundle is registered for the domain,
+// and an error wrapping ErrDictionaryLoad if the stopwords of the bundle can't be parsed.
+func (als *ArabicLightStemmer) WithDomain(domain Domain) (*ArabicLightStemmer, error) {
+	domainsMu.RLock()
+	bundle, exists := domains[domain]
+	domainsMu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDomain, domain)
+	}
+	return als.WithDomainBundle(bundle)
+}
+
+// WithDomainBundle returns a clone of the stemmer whose dictionaries also hold the vocabulary of the bundle,
+// for bundles that aren't registered, e.g. loaded with LoadDomainBundle.
+func (als *ArabicLightStemmer) WithDomainBundle(bundle DomainBundle) (*ArabicLightStemmer, error) {
+	clone := als.Clone()
+	if len(bundle.Stopwords) > 0 {
+		if err := clone.MergeStopwords(bundle.Stopwords, MergePreferUser); err != nil {
+			return nil, err
+		}
+	}
+	if err := clone.MergeRoots(bundle.Roots, MergePreferUser); err != nil {
+		return nil, err
+	}
+	for _, word := range bundle.ProtectedWords {
+		clone.protectedWords.Add(als.wordProcessor.StripTashkeel(word))
+	}
+	return clone, nil
+}
@@ -0,0 +1,60 @@
+package stemmer
+
+import (
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/arlstem"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/light10ext"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/lucene"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/snowball"
+)
+
+// Engine selects the algorithm LightStem applies to words. Root, segmentation and the other analyses
+// always use the stemmer's own algorithm.
+type Engine int
+
+const (
+	// EngineLight is the stemmer's own affix-based light stemming, derived from Tashaphyne. It is the default.
+	EngineLight Engine = iota
+	// EngineARLSTem is the ARLSTem algorithm of Abainia et al., see the arlstem package. It removes feminine
+	// markers and verb prefixes by rule rather than by validating affix combinations.
+	EngineARLSTem
+	// EngineSnowball is the Arabic stemmer of the Snowball project, see the snowball package. It gives the stems
+	// of snowballstem based systems, e.g. to keep an index built by Solr or Sphinx.
+	EngineSnowball
+	// EngineLight10 is Larkey's Light10, as implemented by Lucene, see the lucene package. It gives the stems of
+	// SetLuceneCompatible, as an engine choice.
+	EngineLight10
+	// EngineLight10Extended is Light10 extended with combined proclitics and repeated suffix removal, see the
+	// light10ext package. It is the stemmer's own rule set rather than a published algorithm.
+	EngineLight10Extended
+)
+
+// SetEngine sets the algorithm applied by LightStem. Lucene compatibility takes precedence over it.
+// It returns ErrInvalidOption if the engine is unknown.
+func (als *ArabicLightStemmer) SetEngine(engine Engine) error {
+	if engine < EngineLight || engine > EngineLight10Extended {
+		return fmt.Errorf("%w: engine %d", ErrInvalidOption, engine)
+	}
+	als.engine = engine
+	return nil
+}
+
+// GetEngine returns the algorithm applied by LightStem. The default is EngineLight.
+func (als *ArabicLightStemmer) GetEngine() Engine {
+	return als.engine
+}
+
+// engineStem returns the stem given by an engine other than EngineLight, and false for EngineLight.
+func (als *ArabicLightStemmer) engineStem(word string) (string, bool) {
+	switch als.engine {
+	case EngineARLSTem:
+		return arlstem.Stem(word), true
+	case EngineSnowball:
+		return snowball.Stem(word), true
+	case EngineLight10:
+		return lucene.Stem(lucene.Normalize(word)), true
+	case EngineLight10Extended:
+		return light10ext.Stem(word), true
+	}
+	return "", false
+}
@@ -0,0 +1,31 @@
+package stemmer
+
+import (
+	"errors"
+)
+
+// Errors returned by the setters and loaders when the configuration is invalid or a dictionary can't be loaded.
+// Use errors.Is to test for them, as they are usually wrapped with details such as the offending value or path.
+var (
+	// ErrInvalidJoker is returned when the joker is not exactly one character.
+	ErrInvalidJoker = errors.New("stemmer: joker must be a single character")
+	// ErrInvalidLength is returned for affix or stem lengths lower than one.
+	ErrInvalidLength = errors.New("stemmer: length must be at least 1")
+	// ErrEmptyLetters is returned when the prefix or suffix letters are empty.
+	ErrEmptyLetters = errors.New("stemmer: empty letter set")
+	// ErrEmptyAffixList is returned when the prefix or suffix list is empty.
+	ErrEmptyAffixList = errors.New("stemmer: empty affix list")
+	// ErrEmptyRootsList is returned when the roots list is empty.
+	ErrEmptyRootsList = errors.New("stemmer: empty roots list")
+	// ErrEmptyVerbList is returned when the verb list is empty.
+	ErrEmptyVerbList = errors.New("stemmer: empty verb list")
+	// ErrInvalidOption is returned for an option, such as a segmentation strategy or a threshold, out of its range.
+	ErrInvalidOption = errors.New("stemmer: invalid option value")
+	// ErrDictionaryLoad is returned when a dictionary file can't be read or parsed. It wraps the underlying
+	// error, so I/O failures can also be tested with errors.Is, e.g. against fs.ErrNotExist.
+	ErrDictionaryLoad = errors.New("stemmer: cannot load dictionary")
+	// ErrUnknownDomain is returned by WithDomain for a domain without a registered bundle.
+	ErrUnknownDomain = errors.New("stemmer: unknown domain")
+	// ErrMergeConflict is returned by MergeStopwords under MergeError when merged entries differ from the current ones.
+	ErrMergeConflict = errors.New("stemmer: conflicting dictionary entries")
+)
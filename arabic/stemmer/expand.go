@@ -0,0 +1,58 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/pattern"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+)
+
+// IndexWords adds the given words to the expansion index used by Expand.
+// Each word is recorded under its root, so that Expand can return surface forms attested in a corpus
+// before falling back to forms generated from templates.
+func (als *ArabicLightStemmer) IndexWords(words []string) {
+	for _, word := range words {
+		unvocalized := als.wordProcessor.StripTashkeel(word)
+		root := als.patternRoot(unvocalized)
+		if root == "" {
+			continue
+		}
+		if !utils.Contains(als.expansionIndex[root], unvocalized) {
+			als.expansionIndex[root] = append(als.expansionIndex[root], unvocalized)
+		}
+	}
+}
+
+// Expand returns surface forms sharing the root of the given word, for query expansion.
+// Forms found in the expansion index are listed first, followed by forms generated from the pattern list.
+// The word itself is not included. A limit of zero or less returns all forms.
+func (als *ArabicLightStemmer) Expand(word string, limit int) []string {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	root := als.patternRoot(unvocalized)
+	if root == "" {
+		return nil
+	}
+
+	var forms []string
+	add := func(form string) bool {
+		if form == unvocalized || utils.Contains(forms, form) {
+			return true
+		}
+		forms = append(forms, form)
+		return limit <= 0 || len(forms) < limit
+	}
+
+	for _, form := range als.expansionIndex[root] {
+		if !add(form) {
+			return forms
+		}
+	}
+	for _, template := range als.patternMatcher.Templates() {
+		form, err := pattern.Generate(root, template)
+		if err != nil {
+			continue
+		}
+		if !add(form) {
+			return forms
+		}
+	}
+	return forms
+}
@@ -0,0 +1,58 @@
+package stemmer
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// AffixConfig is the JSON form of the affix configuration written by ExportAffixConfig.
+// Its field names match the keys of the arstem YAML configuration, so an export can be used as a configuration file.
+type AffixConfig struct {
+	PrefixLetters    string   `json:"prefix_letters"`
+	SuffixLetters    string   `json:"suffix_letters"`
+	InfixLetters     string   `json:"infix_letters"`
+	Joker            string   `json:"joker"`
+	MaxPrefixLength  int      `json:"max_prefix_length"`
+	MaxSuffixLength  int      `json:"max_suffix_length"`
+	MinStemLength    int      `json:"min_stem_length"`
+	PrefixList       []string `json:"prefix_list"`
+	SuffixList       []string `json:"suffix_list"`
+	ValidAffixesList []string `json:"valid_affixes_list"`
+}
+
+// ExportRoots writes the roots dictionary in use as a JSON array of strings, in dictionary order.
+func (als *ArabicLightStemmer) ExportRoots(w io.Writer) error {
+	return writeJSON(w, als.rootsManager.Roots())
+}
+
+// ExportStopwords writes the stopwords in use as a JSON object in the format of stopwords.json,
+// keyed by unvocalized word. The output can be loaded back through DictionaryFiles and Reload.
+func (als *ArabicLightStemmer) ExportStopwords(w io.Writer) error {
+	return als.stopWordManager.Export(w)
+}
+
+// ExportAffixConfig writes the letters, lengths and affix lists in use as a JSON AffixConfig object,
+// reflecting every customization made through the setters or Reload.
+func (als *ArabicLightStemmer) ExportAffixConfig(w io.Writer) error {
+	affixes := als.affixes.Load()
+	return writeJSON(w, AffixConfig{
+		PrefixLetters:    als.prefixLetters,
+		SuffixLetters:    als.suffixLetters,
+		InfixLetters:     als.infixLetters,
+		Joker:            als.joker,
+		MaxPrefixLength:  als.maxPrefixLength,
+		MaxSuffixLength:  als.maxSuffixLength,
+		MinStemLength:    als.minStemLength,
+		PrefixList:       affixes.prefixList,
+		SuffixList:       affixes.suffixList,
+		ValidAffixesList: als.validAffixesList,
+	})
+}
+
+// writeJSON writes the value as indented JSON, leaving non-ASCII letters unescaped.
+func writeJSON(w io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
@@ -0,0 +1,131 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/dictfile"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"maps"
+	"strings"
+)
+
+// expressionProclitics lists the proclitics the first word of an expression may carry, e.g. بوزارة الخارجية.
+var expressionProclitics = []string{"و", "ف", "ب", "ل", "ك"}
+
+// SetExpressions sets the multi-word expressions, such as رأس المال or وزارة الخارجية, that Tokenize keeps as single
+// tokens, each mapped to its canonical key: LightStem and Root return the key of an expression token, or the expression
+// normalized for search if its key is empty. The expressions are tokenized like text and stripped of tashkeel, and
+// their first word may carry a proclitic in text, e.g. ووزارة الخارجية. An empty map disables expressions.
+func (als *ArabicLightStemmer) SetExpressions(expressions map[string]string) {
+	als.expressions = utils.NewTrie[string]()
+	als.expressionKeys = make(map[string]string, len(expressions))
+	for expression, key := range expressions {
+		words := als.tokenizer.Tokenize(expression)
+		if len(words) == 0 {
+			continue
+		}
+		for i, word := range words {
+			words[i] = als.wordProcessor.StripTashkeel(word)
+		}
+		expression = strings.Join(words, " ")
+		if key == "" {
+			key = als.NormalizeSearchText(expression)
+		}
+		als.expressionKeys[expression] = key
+		als.expressions.Insert(words)
+	}
+}
+
+// GetExpressions returns the multi-word expressions, tokenized and stripped of tashkeel, with their keys.
+func (als *ArabicLightStemmer) GetExpressions() map[string]string {
+	return maps.Clone(als.expressionKeys)
+}
+
+// LoadExpressions replaces the multi-word expressions with those of a file holding one expression per line,
+// optionally followed by a tab and its key, e.g. "رأس المال\tرأسمال". A versioned header is checked;
+// errors wrap ErrDictionaryLoad.
+func (als *ArabicLightStemmer) LoadExpressions(path string) error {
+	lines, err := readLines(path, dictfile.KindExpressions)
+	if err != nil {
+		return err
+	}
+	expressions := make(map[string]string, len(lines))
+	for _, line := range lines {
+		expression, key, _ := strings.Cut(line, "\t")
+		expressions[strings.TrimSpace(expression)] = strings.TrimSpace(key)
+	}
+	als.SetExpressions(expressions)
+	return nil
+}
+
+// ExpressionKey returns the key of a token made by Tokenize of a multi-word expression, and false if the token
+// isn't one.
+func (als *ArabicLightStemmer) ExpressionKey(token string) (string, bool) {
+	if len(als.expressionKeys) == 0 || !strings.Contains(token, " ") {
+		return "", false
+	}
+	expression := als.wordProcessor.StripTashkeel(token)
+	if key, ok := als.expressionKeys[expression]; ok {
+		return key, true
+	}
+	for _, proclitic := range expressionProclitics {
+		if rest, ok := strings.CutPrefix(expression, proclitic); ok {
+			if key, ok := als.expressionKeys[rest]; ok {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+// mergeExpressions joins the tokens of each multi-word expression, longest first, into a single token
+// with its words separated by spaces.
+func (als *ArabicLightStemmer) mergeExpressions(tokens []string) []string {
+	if len(als.expressionKeys) == 0 {
+		return tokens
+	}
+	merged := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		if length := als.matchExpression(tokens[i:]); length > 1 {
+			merged = append(merged, strings.Join(tokens[i:i+length], " "))
+			i += length - 1
+			continue
+		}
+		merged = append(merged, tokens[i])
+	}
+	return merged
+}
+
+// matchExpression returns the number of tokens of the longest expression the tokens start with, or 0 if none.
+func (als *ArabicLightStemmer) matchExpression(tokens []string) int {
+	if len(tokens) == 0 {
+		return 0
+	}
+	first := als.wordProcessor.StripTashkeel(tokens[0])
+	longest := als.matchExpressionFrom(first, tokens[1:])
+	for _, proclitic := range expressionProclitics {
+		if rest, ok := strings.CutPrefix(first, proclitic); ok && rest != "" {
+			longest = max(longest, als.matchExpressionFrom(rest, tokens[1:]))
+		}
+	}
+	return longest
+}
+
+// matchExpressionFrom returns the number of tokens of the longest expression made of the first word
+// followed by the tokens, or 0 if none.
+func (als *ArabicLightStemmer) matchExpressionFrom(first string, tokens []string) int {
+	node, ok := als.expressions.Child(first)
+	if !ok {
+		return 0
+	}
+	longest := 0
+	for i, token := range tokens {
+		child, ok := node.Child(als.wordProcessor.StripTashkeel(token))
+		if !ok {
+			break
+		}
+		node = child
+		if node.Terminal() {
+			longest = i + 2
+		}
+	}
+	return longest
+}
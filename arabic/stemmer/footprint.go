@@ -0,0 +1,55 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+)
+
+// MemoryStats is the approximate memory, in bytes, held by the dictionaries and caches of a stemmer.
+// The figures estimate the Go data structures on 64-bit platforms; they are meant for budgeting, not accounting.
+type MemoryStats struct {
+	// Roots is the roots dictionary with its lookup trie.
+	Roots int `json:"roots"`
+	// Stopwords is the stopwords with their annotations and variant index, and the stop phrases.
+	Stopwords int `json:"stopwords"`
+	// Affixes is the prefix and suffix lists with their lookup trees.
+	Affixes int `json:"affixes"`
+	// ProtectedWords is the set of the words left unstemmed.
+	ProtectedWords int `json:"protected_words"`
+	// Caches is the expansion index filled by IndexWords.
+	Caches int `json:"caches"`
+}
+
+// Total returns the sum of the memory of every part.
+func (ms MemoryStats) Total() int {
+	return ms.Roots + ms.Stopwords + ms.Affixes + ms.ProtectedWords + ms.Caches
+}
+
+// Footprint estimates the memory held by the dictionaries and caches of the stemmer, e.g. to decide which
+// dictionaries to load on a constrained device. Dictionaries shared with clones are counted for every stemmer,
+// and roots and stopword managers that don't implement utils.Footprinter count for nothing.
+func (als *ArabicLightStemmer) Footprint() MemoryStats {
+	affixes := als.affixes.Load()
+	stats := MemoryStats{
+		Roots:     footprint(als.rootsManager),
+		Stopwords: footprint(als.stopWordManager) + utils.StringsSize(als.stopPhraseList) + als.stopPhrases.Footprint(),
+		Affixes: utils.StringsSize(affixes.prefixList) + utils.StringsSize(affixes.suffixList) +
+			affixes.prefixesTree.Footprint() + affixes.suffixesTree.Footprint(),
+		ProtectedWords: utils.MapHeaderSize,
+		Caches:         utils.MapHeaderSize,
+	}
+	for root, forms := range als.expansionIndex {
+		stats.Caches += utils.StringSize(root) + utils.StringsSize(forms) + utils.MapEntryOverhead
+	}
+	for _, word := range als.protectedWords.Values() {
+		stats.ProtectedWords += utils.StringSize(word) + utils.MapEntryOverhead
+	}
+	return stats
+}
+
+// footprint returns the memory held by the dictionary if it can estimate it, and 0 otherwise.
+func footprint(dictionary any) int {
+	if footprinter, ok := dictionary.(utils.Footprinter); ok {
+		return footprinter.Footprint()
+	}
+	return 0
+}
@@ -0,0 +1,53 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"strings"
+)
+
+// GroupByStem groups the given words by their normalized light stem.
+// Words are stripped of diacritics before grouping, stopwords and empty words are left out, and each group keeps
+// the distinct words in the order they first appear.
+func (als *ArabicLightStemmer) GroupByStem(words []string) map[string][]string {
+	groups := make(map[string][]string)
+	for _, word := range words {
+		key, unvocalized, ok := als.groupKey(word)
+		if !ok {
+			continue
+		}
+		if !utils.Contains(groups[key], unvocalized) {
+			groups[key] = append(groups[key], unvocalized)
+		}
+	}
+	return groups
+}
+
+// UniqueByStem returns the first word found for each normalized light stem, in input order.
+// Like GroupByStem, it strips diacritics and leaves out stopwords and empty words.
+func (als *ArabicLightStemmer) UniqueByStem(words []string) []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, word := range words {
+		key, unvocalized, ok := als.groupKey(word)
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, unvocalized)
+	}
+	return unique
+}
+
+// groupKey returns the normalized light stem used to group a word, along with the unvocalized word.
+// It returns false for stopwords and words without a stem.
+func (als *ArabicLightStemmer) groupKey(word string) (string, string, bool) {
+	unvocalized := strings.TrimSpace(als.wordProcessor.StripTashkeel(word))
+	if unvocalized == "" || als.stopWordManager.IsStopword(unvocalized) {
+		return "", "", false
+	}
+	key := als.NormalizeSearchText(als.LightStem(unvocalized))
+	if key == "" {
+		return "", "", false
+	}
+	return key, unvocalized, true
+}
@@ -0,0 +1,84 @@
+package stemmer
+
+import (
+	"regexp"
+	"strings"
+)
+
+// hashtagPattern matches a hashtag: a hash sign followed by letters, marks, digits and underscores.
+var hashtagPattern = regexp.MustCompile(`#[\p{L}\p{M}\p{N}_]+`)
+
+// Hashtag is a social media hashtag split into its words, e.g. #يوم_الجمعة into يوم and الجمعة.
+type Hashtag struct {
+	// Tag is the hashtag as written, including the hash sign.
+	Tag string `json:"tag"`
+	// Components are the words of the hashtag, split on underscores.
+	Components []string `json:"components"`
+	// Stems holds the light stem of each component, in the same order.
+	Stems []string `json:"stems"`
+}
+
+// SetHashtagAware enables or disables the hashtag mode. When enabled, Tokenize keeps hashtags such as
+// #يوم_الجمعة as single tokens, and LightStem stems each of their words, e.g. #يوم_جمعة.
+func (als *ArabicLightStemmer) SetHashtagAware(hashtagAware bool) {
+	als.hashtagAware = hashtagAware
+}
+
+// GetHashtagAware returns whether the hashtag mode is enabled.
+// Hashtags are split into separate words by Tokenize when it is disabled, which is the default.
+func (als *ArabicLightStemmer) GetHashtagAware() bool {
+	return als.hashtagAware
+}
+
+// StemHashtag splits the hashtag on underscores and stems each component.
+// It returns false if the given token is not a hashtag.
+func (als *ArabicLightStemmer) StemHashtag(tag string) (Hashtag, bool) {
+	if !strings.HasPrefix(tag, "#") {
+		return Hashtag{}, false
+	}
+	hashtag := Hashtag{Tag: tag}
+	for _, component := range strings.Split(strings.TrimPrefix(tag, "#"), "_") {
+		if component == "" {
+			continue
+		}
+		hashtag.Components = append(hashtag.Components, component)
+		hashtag.Stems = append(hashtag.Stems, als.LightStem(component))
+	}
+	if len(hashtag.Components) == 0 {
+		return Hashtag{}, false
+	}
+	return hashtag, true
+}
+
+// ExtractHashtags returns the hashtags of the text with their stemmed components, in the order they appear.
+// It does not depend on the hashtag mode.
+func (als *ArabicLightStemmer) ExtractHashtags(text string) []Hashtag {
+	var hashtags []Hashtag
+	for _, tag := range hashtagPattern.FindAllString(text, -1) {
+		if hashtag, ok := als.StemHashtag(tag); ok {
+			hashtags = append(hashtags, hashtag)
+		}
+	}
+	return hashtags
+}
+
+// stemHashtag returns the hashtag written back with the stems of its components, e.g. #يوم_جمعة.
+func (als *ArabicLightStemmer) stemHashtag(tag string) (string, bool) {
+	hashtag, ok := als.StemHashtag(tag)
+	if !ok {
+		return "", false
+	}
+	return "#" + strings.Join(hashtag.Stems, "_"), true
+}
+
+// tokenizeHashtags tokenizes the text like the tokenizer, except that hashtags are kept as single tokens.
+func (als *ArabicLightStemmer) tokenizeHashtags(text string) []string {
+	var tokens []string
+	last := 0
+	for _, match := range hashtagPattern.FindAllStringIndex(text, -1) {
+		tokens = append(tokens, als.tokenizer.Tokenize(text[last:match[0]])...)
+		tokens = append(tokens, text[match[0]:match[1]])
+		last = match[1]
+	}
+	return append(tokens, als.tokenizer.Tokenize(text[last:])...)
+}
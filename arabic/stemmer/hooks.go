@@ -0,0 +1,104 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+)
+
+// RejectionReason tells why a segmentation was rejected by the stemmer.
+type RejectionReason string
+
+const (
+	// RejectedAffixRule means the prefix-suffix pair isn't allowed by the affix rules.
+	RejectedAffixRule RejectionReason = "affix_rule"
+	// RejectedStem means the affixes are allowed, but the stem isn't valid with them, e.g. too short.
+	RejectedStem RejectionReason = "stem"
+	// RejectedRoot means the root of the segmentation isn't in the roots dictionary, while one is required.
+	RejectedRoot RejectionReason = "root"
+)
+
+// Hooks are callbacks observing the intermediate steps of stemming, e.g. for test harnesses asserting on them
+// or for rejection statistics on a corpus. Any of them may be nil. They are called synchronously,
+// so hooks of a stemmer used concurrently must be safe for concurrent use.
+type Hooks struct {
+	// BeforeSegment is called with the unvocalized word before its segmentations are looked up.
+	BeforeSegment func(word string)
+	// AfterSegment is called with the unvocalized word and its candidate segmentations, of which only
+	// the prefix, stem and suffix are set, before they are checked against the affix rules.
+	AfterSegment func(word string, segmentations []Segmentation)
+	// OnAffixRejected is called for every segmentation rejected while choosing the stem.
+	OnAffixRejected func(prefix, stem, suffix string, reason RejectionReason)
+}
+
+// SetHooks sets the callbacks observing the intermediate steps of stemming, replacing the previous ones.
+// Pass the zero Hooks to remove them.
+func (als *ArabicLightStemmer) SetHooks(hooks Hooks) {
+	als.hooks = hooks
+}
+
+// GetHooks returns the callbacks observing the intermediate steps of stemming.
+func (als *ArabicLightStemmer) GetHooks() Hooks {
+	return als.hooks
+}
+
+// afterSegment calls the AfterSegment hook with the segmentations of the segment list.
+func (als *ArabicLightStemmer) afterSegment(unvocalized string, segmentList map[int][][2]int) {
+	runeWord := []rune(unvocalized)
+	var segmentations []Segmentation
+	for _, segment := range sortedSegments(segmentList) {
+		left, right := segment[0], segment[1]
+		if right > len(runeWord) {
+			continue
+		}
+		segmentations = append(segmentations, Segmentation{
+			Prefix: string(runeWord[:left]),
+			Stem:   string(runeWord[left:right]),
+			Suffix: string(runeWord[right:]),
+		})
+	}
+	als.hooks.AfterSegment(unvocalized, segmentations)
+}
+
+// affixRejection returns why the prefix-suffix pair with the given stem isn't valid for the part of speech,
+// or an empty reason if it is.
+func (als *ArabicLightStemmer) affixRejection(tag, prefix, suffix, stem string) RejectionReason {
+	if !als.affixRules.Allows(tag, prefix, suffix, stem) {
+		return RejectedAffixRule
+	}
+	if !als.validStem(stem, tag, prefix) {
+		return RejectedStem
+	}
+	return ""
+}
+
+// rejectAffix calls the OnAffixRejected hook, if any, and returns false.
+func (als *ArabicLightStemmer) rejectAffix(prefix, stem, suffix string, reason RejectionReason) bool {
+	if als.hooks.OnAffixRejected != nil {
+		als.hooks.OnAffixRejected(prefix, stem, suffix, reason)
+	}
+	return false
+}
+
+// acceptedAffix checks the segmentation against the affixes of the requested part of speech, or of verbs
+// and nouns, reporting a rejection to the hooks. When both parts of speech reject it, the stem is blamed
+// if either allowed the affixes.
+func (als *ArabicLightStemmer) acceptedAffix(prefix, suffix, stem string) bool {
+	switch als.affixTag {
+	case affix.TagVerb, affix.TagNoun:
+		if reason := als.affixRejection(als.affixTag, prefix, suffix, stem); reason != "" {
+			return als.rejectAffix(prefix, stem, suffix, reason)
+		}
+		return true
+	}
+	verbReason := als.affixRejection(affix.TagVerb, prefix, suffix, stem)
+	if verbReason == "" {
+		return true
+	}
+	nounReason := als.affixRejection(affix.TagNoun, prefix, suffix, stem)
+	if nounReason == "" {
+		return true
+	}
+	if verbReason == RejectedStem {
+		nounReason = RejectedStem
+	}
+	return als.rejectAffix(prefix, stem, suffix, nounReason)
+}
@@ -0,0 +1,8 @@
+package stemmer
+
+// Stemmer is implemented by every stemming engine and configuration that can be compared or swapped for one another.
+type Stemmer interface {
+	LightStem(word string) string
+}
+
+var _ Stemmer = (*ArabicLightStemmer)(nil)
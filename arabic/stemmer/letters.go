@@ -0,0 +1,29 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/chars"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"unicode"
+)
+
+// letterClasses holds the range tables built from the prefix, suffix and infix letters of a stemmer.
+// They are rebuilt whenever one of the letter sets changes, so membership tests never rescan the strings.
+type letterClasses struct {
+	prefix    *unicode.RangeTable
+	suffix    *unicode.RangeTable
+	affix     *unicode.RangeTable
+	infix     *unicode.RangeTable
+	infixStem *unicode.RangeTable
+}
+
+// newLetterClasses builds the range tables for the given letter sets.
+// The infix stem class also accepts TEH MARBUTA, which is kept when marking the letters of a stem.
+func newLetterClasses(prefixLetters, suffixLetters, infixLetters string) letterClasses {
+	return letterClasses{
+		prefix:    chars.NewTable(prefixLetters),
+		suffix:    chars.NewTable(suffixLetters),
+		affix:     chars.NewTable(prefixLetters + suffixLetters),
+		infix:     chars.NewTable(infixLetters),
+		infixStem: chars.NewTable(infixLetters + constant.TEH_MARBUTA),
+	}
+}
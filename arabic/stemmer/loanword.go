@@ -0,0 +1,32 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+)
+
+// IsLoanword checks if the given word is likely a transliterated foreign word or name, such as كمبيوتر or إنستغرام.
+// The detection relies on a list of known loanwords, letters used only in transliteration and typical foreign morphemes.
+func (als *ArabicLightStemmer) IsLoanword(word string) bool {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	return als.loanwordDetector.IsLoanword(stripDefiniteArticle(unvocalized))
+}
+
+// skipLoanword returns the lightly normalized form of the word and true if loanwords are skipped and the word is one.
+// Light normalization removes diacritics and a leading definite article with its proclitics.
+func (als *ArabicLightStemmer) skipLoanword(word string) (string, bool) {
+	if !als.skipLoanwords || !als.IsLoanword(word) {
+		return "", false
+	}
+	return stripDefiniteArticle(als.wordProcessor.StripTashkeel(word)), true
+}
+
+// stripDefiniteArticle removes a leading definite article, possibly preceded by a proclitic, from an unvocalized word.
+func stripDefiniteArticle(word string) string {
+	for _, article := range constant.DEFINITE_ARTICLES {
+		if strings.HasPrefix(word, article) && len([]rune(word))-len([]rune(article)) >= 2 {
+			return strings.TrimPrefix(word, article)
+		}
+	}
+	return word
+}
@@ -0,0 +1,104 @@
+package stemmer
+
+import (
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stop_words"
+	"strings"
+)
+
+// MergePolicy resolves the conflicts between the entries of a dictionary layered on top of the current one
+// and the current entries. Only dictionaries whose entries carry data can conflict, i.e. the stopwords, whose
+// annotations may differ; roots and affixes are plain sets, so an entry already present is kept once whatever the
+// policy.
+type MergePolicy int
+
+const (
+	// MergePreferUser keeps the merged entry when it conflicts with a current one.
+	MergePreferUser MergePolicy = iota
+	// MergePreferBase keeps the current entry when a merged one conflicts with it.
+	MergePreferBase
+	// MergeError rejects the merge, returning ErrMergeConflict, when any merged entry conflicts with a current one.
+	MergeError
+)
+
+// MergeRoots adds the roots to the roots dictionary. A root already in the dictionary is kept once and doesn't
+// conflict with it, so the policy is only checked for validity.
+// A new dictionary is built, so clones sharing the previous dictionary are not affected.
+func (als *ArabicLightStemmer) MergeRoots(roots []string, policy MergePolicy) error {
+	if err := validMergePolicy(policy); err != nil {
+		return err
+	}
+	current := als.rootsManager.Roots()
+	merged := mergeList(current, roots, als.rootsManager.IsRoot)
+	if len(merged) == len(current) {
+		return nil
+	}
+	return als.SetRootsList(merged)
+}
+
+// MergeStopwords adds the stopwords of a JSON document, in the format of stop_words/stopwords.json, to the stopwords.
+// A word of the document conflicts with a current stopword when their entries differ; the policy selects the entry
+// kept. The merged stopwords are held by the stemmer alone, so clones aren't affected, and are replaced by Reload.
+// It returns an error wrapping ErrDictionaryLoad if the document can't be parsed.
+func (als *ArabicLightStemmer) MergeStopwords(data []byte, policy MergePolicy) error {
+	if err := validMergePolicy(policy); err != nil {
+		return err
+	}
+	merger, ok := als.stopWordManager.(stop_words.StopwordMerger)
+	if !ok {
+		return fmt.Errorf("%w: stopwords: the stopword manager doesn't support merging", ErrDictionaryLoad)
+	}
+	stopWordManager, conflicts, err := merger.Merge(data, policy == MergePreferUser)
+	if err != nil {
+		return fmt.Errorf("%w: stopwords: %w", ErrDictionaryLoad, err)
+	}
+	if policy == MergeError && len(conflicts) > 0 {
+		return fmt.Errorf("%w: stopwords %s", ErrMergeConflict, strings.Join(conflicts, ", "))
+	}
+	als.stopWordManager = stopWordManager
+	return nil
+}
+
+// MergeAffixes adds the prefixes and suffixes to the affix lists. An affix already in its list is kept once and
+// doesn't conflict with it, so the policy is only checked for validity.
+func (als *ArabicLightStemmer) MergeAffixes(prefixes, suffixes []string, policy MergePolicy) error {
+	if err := validMergePolicy(policy); err != nil {
+		return err
+	}
+	affixes := als.affixes.Load()
+	prefixList := mergeList(affixes.prefixList, prefixes, contains(affixes.prefixList))
+	suffixList := mergeList(affixes.suffixList, suffixes, contains(affixes.suffixList))
+	als.affixes.Store(newAffixSet(prefixList, suffixList))
+	return nil
+}
+
+// mergeList appends the entries that aren't in the current list, once each, to a copy of it.
+func mergeList(current, entries []string, exists func(string) bool) []string {
+	merged := append([]string{}, current...)
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		if seen[entry] || exists(entry) {
+			continue
+		}
+		seen[entry] = true
+		merged = append(merged, entry)
+	}
+	return merged
+}
+
+// contains returns a function reporting whether an entry is in the list.
+func contains(list []string) func(string) bool {
+	entries := make(map[string]bool, len(list))
+	for _, entry := range list {
+		entries[entry] = true
+	}
+	return func(entry string) bool { return entries[entry] }
+}
+
+// validMergePolicy returns an error wrapping ErrInvalidOption if the policy is unknown.
+func validMergePolicy(policy MergePolicy) error {
+	if policy < MergePreferUser || policy > MergeError {
+		return fmt.Errorf("%w: merge policy %d", ErrInvalidOption, policy)
+	}
+	return nil
+}
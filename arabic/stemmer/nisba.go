@@ -0,0 +1,30 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/nisba"
+	"strings"
+)
+
+// AnalyzeNisba checks whether the given word is a nisba adjective (e.g. عراقي، مصرية) and reports its base noun
+// along with gender and number. Stopwords and words whose final YEH is a root letter are not considered nisba forms.
+func (als *ArabicLightStemmer) AnalyzeNisba(word string) (nisba.Nisba, bool) {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	if als.stopWordManager.IsStopword(unvocalized) {
+		return nisba.Nisba{}, false
+	}
+	return als.analyzeNisba(unvocalized)
+}
+
+// analyzeNisba analyzes an unvocalized word as a nisba adjective, rejecting the words whose root ends in YEH or WAW,
+// as the final YEH is then a root letter rather than the nisba ending, e.g. قاضي, مبني or الثاني.
+func (als *ArabicLightStemmer) analyzeNisba(unvocalized string) (nisba.Nisba, bool) {
+	n, ok := als.nisbaAnalyzer.Analyze(unvocalized)
+	if !ok {
+		return nisba.Nisba{}, false
+	}
+	if root := als.findRoot(unvocalized); strings.HasSuffix(root, constant.YEH) || strings.HasSuffix(root, constant.WAW) {
+		return nisba.Nisba{}, false
+	}
+	return n, true
+}
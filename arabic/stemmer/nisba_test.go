@@ -0,0 +1,27 @@
+package stemmer
+
+import "testing"
+
+func TestAnalyzeNisbaRootYeh(t *testing.T) {
+	tests := []struct {
+		word  string
+		base  string
+		nisba bool
+	}{
+		{"عراقي", "عراق", true},
+		{"مصرية", "مصر", true},
+		{"تعليمي", "تعليم", true},
+		{"قاضي", "", false},
+		{"الماضي", "", false},
+		{"مبني", "", false},
+		{"الثاني", "", false},
+		{"مستشفي", "", false},
+	}
+	als := NewArabicLightStemmer()
+	for _, tt := range tests {
+		n, ok := als.AnalyzeNisba(tt.word)
+		if ok != tt.nisba || n.Base != tt.base {
+			t.Errorf("AnalyzeNisba(%q) = %q, %v, want %q, %v", tt.word, n.Base, ok, tt.base, tt.nisba)
+		}
+	}
+}
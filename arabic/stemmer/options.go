@@ -0,0 +1,71 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// StemOptions constrains a single StemWithOptions call. Zero values leave the stemmer's configuration unchanged.
+type StemOptions struct {
+	// AllowedPrefixes and AllowedSuffixes restrict the stemmer's prefix and suffix lists to the given subsets.
+	// The empty affix is always allowed.
+	AllowedPrefixes []string
+	AllowedSuffixes []string
+	// MaxPrefixLength and MaxSuffixLength drop the affixes longer than the given number of letters.
+	MaxPrefixLength int
+	MaxSuffixLength int
+	// PartOfSpeech restricts the affix combinations to those of a "verb" or a "noun", as StemAsVerb and StemAsNoun do.
+	PartOfSpeech string
+	// RequireDictionaryRoot rejects the segmentations whose root isn't in the roots dictionary.
+	RequireDictionaryRoot bool
+}
+
+// StemWithOptions light stems the word under the given per-call constraints, without modifying the stemmer.
+// Unlike the setters, it is safe to call concurrently with different options, which suits servers
+// sharing one stemmer between requests.
+func (als *ArabicLightStemmer) StemWithOptions(word string, opts StemOptions) string {
+	return als.withOptions(opts).LightStem(word)
+}
+
+// withOptions returns a shallow copy of the stemmer with the given options applied.
+// Affix trees are rebuilt on the copy only when the affix lists change.
+func (als *ArabicLightStemmer) withOptions(opts StemOptions) *ArabicLightStemmer {
+	constrained := *als
+	if opts.AllowedPrefixes != nil || opts.MaxPrefixLength > 0 || opts.AllowedSuffixes != nil || opts.MaxSuffixLength > 0 {
+		affixes := als.affixes.Load()
+		prefixList, suffixList := affixes.prefixList, affixes.suffixList
+		if opts.AllowedPrefixes != nil || opts.MaxPrefixLength > 0 {
+			prefixList = filterAffixes(prefixList, opts.AllowedPrefixes, opts.MaxPrefixLength)
+		}
+		if opts.AllowedSuffixes != nil || opts.MaxSuffixLength > 0 {
+			suffixList = filterAffixes(suffixList, opts.AllowedSuffixes, opts.MaxSuffixLength)
+		}
+		constrained.affixes = new(atomic.Pointer[affixSet])
+		constrained.affixes.Store(newAffixSet(prefixList, suffixList))
+	}
+	if opts.PartOfSpeech != "" {
+		constrained.affixTag = opts.PartOfSpeech
+	}
+	constrained.requireDictionaryRoot = opts.RequireDictionaryRoot
+	return &constrained
+}
+
+// filterAffixes keeps the affixes of the list that are allowed, if an allowed list is given,
+// and not longer than maxLength, if positive. The empty affix is always kept.
+func filterAffixes(affixes, allowed []string, maxLength int) []string {
+	filtered := []string{""}
+	for _, affix := range affixes {
+		if affix == "" {
+			continue
+		}
+		if allowed != nil && !utils.Contains(allowed, affix) {
+			continue
+		}
+		if maxLength > 0 && utf8.RuneCountInString(affix) > maxLength {
+			continue
+		}
+		filtered = append(filtered, affix)
+	}
+	return filtered
+}
@@ -0,0 +1,41 @@
+package stemmer
+
+import (
+	"context"
+	"golang.org/x/sync/errgroup"
+	"runtime"
+)
+
+// StemWordsParallel light stems the words on a pool of workers sharing this stemmer, and returns the stems
+// in the order of the words. Zero or fewer workers uses runtime.GOMAXPROCS(0). The stemmer must not be
+// reconfigured while the call runs. If the context is done before all words are stemmed, the context's
+// error is returned along with the stems computed so far, the others being empty.
+func (als *ArabicLightStemmer) StemWordsParallel(ctx context.Context, words []string, workers int) ([]string, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	stems := make([]string, len(words))
+	indices := make(chan int)
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		defer close(indices)
+		for i := range words {
+			select {
+			case indices <- i:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+	for i := 0; i < workers; i++ {
+		group.Go(func() error {
+			for i := range indices {
+				stems[i] = als.LightStem(words[i])
+			}
+			return nil
+		})
+	}
+	return stems, group.Wait()
+}
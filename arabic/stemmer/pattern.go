@@ -0,0 +1,42 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/pattern"
+)
+
+// DetectPattern identifies the morphological templates (awzan) matching the given word.
+// The light stem and the whole unvocalized word are both matched, since light stemming may remove letters
+// that belong to the template (e.g. the MEEM of مفعول). Each result reports the template and the inferred root letters.
+func (als *ArabicLightStemmer) DetectPattern(word string) []pattern.Pattern {
+	if word == "" {
+		return nil
+	}
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	stem := als.LightStem(word)
+
+	var patterns []pattern.Pattern
+	seen := make(map[string]bool)
+	for _, candidate := range []string{stem, unvocalized} {
+		for _, p := range als.patternMatcher.Match(candidate) {
+			key := p.Name + "|" + p.Stem
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// patternRoot returns the root inferred by the first detected pattern whose root exists in the roots dictionary.
+// It returns an empty string when no pattern yields a known root.
+func (als *ArabicLightStemmer) patternRoot(word string) string {
+	for _, p := range als.DetectPattern(word) {
+		root := als.rootsManager.NormalizeRoot(p.Root)
+		if als.rootsManager.IsRoot(root) {
+			return root
+		}
+	}
+	return ""
+}
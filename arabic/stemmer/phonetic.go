@@ -0,0 +1,18 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/phonetic"
+	"unicode/utf8"
+)
+
+// PhoneticKey returns a Soundex-like phonetic key for the given word, computed over its normalized light stem.
+// Words differing only by commonly confused letters (ض/ظ، س/ص، ت/ط) or by affixes share the same key,
+// which makes it suitable for fuzzy name search. Stems shorter than the minimum stem length fall back to the whole word.
+func (als *ArabicLightStemmer) PhoneticKey(word string) string {
+	stem := als.LightStem(word)
+	if utf8.RuneCountInString(stem) < als.minStemLength {
+		stem = als.wordProcessor.StripTashkeel(word)
+	}
+	return phonetic.Encode(normalize.SearchText(stem))
+}
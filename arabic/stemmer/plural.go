@@ -0,0 +1,56 @@
+package stemmer
+
+import (
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/plural"
+)
+
+// Singularize returns the singular form of a broken plural, e.g. مدارس → مدرسة, or the unvocalized word unchanged
+// when it isn't recognized as one. The whole word is tried first, then its light stem, so that attached
+// affixes such as the definite article don't prevent resolution.
+func (als *ArabicLightStemmer) Singularize(word string) string {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	if singular, ok := als.pluralResolver.Singularize(unvocalized); ok {
+		return singular
+	}
+	if singular, ok := als.pluralResolver.Singularize(als.LightStem(word)); ok {
+		return singular
+	}
+	return unvocalized
+}
+
+// AnalyzeNumber identifies dual and sound plural endings of the given word and reports its number and gender
+// along with the normalized singular, e.g. المعلمون → المعلم (plural, masculine). Stopwords are reported as singular.
+func (als *ArabicLightStemmer) AnalyzeNumber(word string) plural.NumberFeatures {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	if als.stopWordManager.IsStopword(unvocalized) {
+		return plural.NumberFeatures{Word: unvocalized, Singular: unvocalized, Number: plural.NumberSingular}
+	}
+	return plural.AnalyzeNumber(unvocalized, als.minStemLength, als.rootsManager)
+}
+
+// AnalyzeFeminine identifies the feminine endings ة, ات, تان and تين of the given word and reports its number and
+// gender along with the base selected by SetFeminineBase, e.g. المعلمات → المعلمة (plural, feminine), or المعلم
+// with FeminineBaseMasculine. Stopwords are reported without a feminine ending.
+func (als *ArabicLightStemmer) AnalyzeFeminine(word string) plural.FeminineFeatures {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	if als.stopWordManager.IsStopword(unvocalized) {
+		return plural.FeminineFeatures{Word: unvocalized, Base: unvocalized, Number: plural.NumberSingular}
+	}
+	return plural.AnalyzeFeminine(unvocalized, als.feminineBase, als.minStemLength, als.rootsManager)
+}
+
+// SetFeminineBase sets the base AnalyzeFeminine produces for feminine forms.
+// It returns ErrInvalidOption if the base is unknown.
+func (als *ArabicLightStemmer) SetFeminineBase(base plural.FeminineBase) error {
+	if base < plural.FeminineBaseSingular || base > plural.FeminineBaseKeep {
+		return fmt.Errorf("%w: feminine base %d", ErrInvalidOption, base)
+	}
+	als.feminineBase = base
+	return nil
+}
+
+// GetFeminineBase returns the base AnalyzeFeminine produces for feminine forms. The default is FeminineBaseSingular.
+func (als *ArabicLightStemmer) GetFeminineBase() plural.FeminineBase {
+	return als.feminineBase
+}
@@ -0,0 +1,25 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+)
+
+// StemAsVerb light stems the word as a conjugated verb, only accepting the prefix-suffix combinations
+// of the verb affix rules. It is meant for callers who already know the part of speech, e.g. from a tagger.
+func (als *ArabicLightStemmer) StemAsVerb(word string) string {
+	return als.withAffixTag(affix.TagVerb).LightStem(word)
+}
+
+// StemAsNoun light stems the word as a noun, only accepting the prefix-suffix combinations of the noun affix rules.
+// It is meant for callers who already know the part of speech, e.g. from a tagger.
+func (als *ArabicLightStemmer) StemAsNoun(word string) string {
+	return als.withAffixTag(affix.TagNoun).LightStem(word)
+}
+
+// withAffixTag returns a shallow copy of the stemmer restricted to the affixes of the given part of speech.
+// The copy shares the dictionaries and trees of the stemmer, so the stemmer itself is left untouched.
+func (als *ArabicLightStemmer) withAffixTag(tag string) *ArabicLightStemmer {
+	constrained := *als
+	constrained.affixTag = tag
+	return &constrained
+}
@@ -0,0 +1,204 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/pattern"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/spelling"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// QueryTerm is a word of a search query normalized by NormalizeQuery.
+type QueryTerm struct {
+	// Text is the word as written in the query.
+	Text string `json:"text"`
+	// Term is the word without tashkeel, definite article and possessive pronoun, e.g. كتاب for الكتاب or كتابه.
+	Term string `json:"term"`
+	// Pronoun is the possessive pronoun removed from the word, e.g. ه in كتابه.
+	Pronoun string `json:"pronoun,omitempty"`
+	// Definite reports whether the word was definite, by its article, its pronoun or the definite noun following it.
+	Definite bool `json:"definite"`
+	// Construct reports whether the word is the first term of an idafa, e.g. كتاب in كتابه or in كتاب الطالب.
+	Construct bool `json:"construct"`
+	// Stopword reports whether the word is a stopword, which is kept as written apart from its tashkeel.
+	Stopword bool `json:"stopword"`
+}
+
+// possessivePronouns lists the pronouns attached to nouns, longest first.
+var possessivePronouns = []string{"هما", "كما", "هم", "هن", "كم", "كن", "نا", "ها", "ه", "ك", "ي"}
+
+// nisbaBaseTemplates matches the nouns from which nisba adjectives are commonly formed, see NISBA_BASE_TEMPLATES.
+var nisbaBaseTemplates = sync.OnceValue(func() pattern.PatternMatcher {
+	return pattern.NewPatternMatcher(constant.NISBA_BASE_TEMPLATES)
+})
+
+// NormalizeQuery prepares a search query with a lighter touch than stemming: the proclitics, the definite article and
+// the possessive pronouns are removed from its words, e.g. بكتابه → كتاب, while their definiteness is kept in each
+// term. The definite article is only removed when the rest of the word has a dictionary root, and kept in the form
+// VIII words whose LAM is a root letter, e.g. التزام. A proclitic or a pronoun is only removed when the word keeps
+// its root, or its light stem, without it, so that وزير, وجه or فقه are left whole. The final YEH is kept as
+// a nisba ending after the nouns of NISBA_BASE_TEMPLATES, e.g. تعليمي, when it is a root letter, e.g. قاضي, and
+// in the adjective following a noun, e.g. كتاب عربي; the TEH MARBUTA written as TEH before a pronoun is restored,
+// e.g. مدرستي → مدرسة. An indefinite noun followed by a definite one is taken for the first term of an idafa,
+// e.g. كتاب الطالب, unless it is a verb, e.g. يكتب الطالب.
+func (als *ArabicLightStemmer) NormalizeQuery(query string) []QueryTerm {
+	var terms []QueryTerm
+	for _, word := range als.Tokenize(query) {
+		term := QueryTerm{Text: word, Term: als.wordProcessor.StripTashkeel(word)}
+		if als.isQueryStopword(term.Term) {
+			term.Stopword = true
+			terms = append(terms, term)
+			continue
+		}
+		var previous *QueryTerm
+		if n := len(terms); n > 0 && !terms[n-1].Stopword {
+			previous = &terms[n-1]
+		}
+		if bare, ok := als.stripQueryArticle(term.Term); ok {
+			term.Term, term.Definite = bare, true
+		} else {
+			term.Term = als.stripQueryProclitics(term.Term)
+			adjective := previous != nil && !als.isQueryVerb(previous.Term)
+			if base, pronoun, ok := als.stripPossessive(term.Term, adjective); ok {
+				term.Term, term.Pronoun = base, pronoun
+				term.Definite, term.Construct = true, true
+			}
+		}
+		if previous != nil && term.Definite && term.Pronoun == "" && !previous.Definite && !als.isQueryVerb(previous.Term) {
+			previous.Definite, previous.Construct = true, true
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// isQueryStopword checks if an unvocalized query word, or the word with a dropped initial hamza or a final YEH
+// written for ALEF MAKSURA, is a stopword, e.g. الى for إلى.
+func (als *ArabicLightStemmer) isQueryStopword(word string) bool {
+	if als.IsStopword(word) {
+		return true
+	}
+	for _, variant := range spelling.Corrections(word) {
+		if als.IsStopword(variant) {
+			return true
+		}
+	}
+	return false
+}
+
+// stripQueryArticle removes the definite article, possibly joined with proclitics, from an unvocalized word if the
+// rest of the word has a dictionary root and isn't the stem of a form VIII word whose LAM is a root letter.
+func (als *ArabicLightStemmer) stripQueryArticle(word string) (string, bool) {
+	bare := stripDefiniteArticle(word)
+	if bare == word || !als.rootsManager.IsRoot(als.findRoot(bare)) {
+		return word, false
+	}
+	for _, p := range als.patternMatcher.Match(constant.ALEF + constant.LAM + bare) {
+		if strings.HasPrefix(p.Name, constant.ALEF+constant.FEH+constant.TEH) && als.rootsManager.IsRoot(als.rootsManager.NormalizeRoot(p.Root)) {
+			return word, false
+		}
+	}
+	return bare, true
+}
+
+// stripQueryProclitics removes up to two proclitics, such as و and ب in وبكتابه, from an unvocalized word as long
+// as the word keeps its dictionary root without them and the rest of the word matches a template. A proclitic
+// letter is kept when it fills a root slot of a template matched by the whole word, e.g. the WAW of وزير.
+func (als *ArabicLightStemmer) stripQueryProclitics(word string) string {
+	root := als.findRoot(word)
+	if !als.rootsManager.IsRoot(root) {
+		return word
+	}
+	for _, p := range als.patternMatcher.Match(word) {
+		if len(p.Slots) > 0 && p.Slots[0] == 0 && als.rootsManager.IsRoot(als.rootsManager.NormalizeRoot(p.Root)) {
+			return word
+		}
+	}
+	for i := 0; i < 2; i++ {
+		stripped := false
+		for _, proclitic := range expressionProclitics {
+			rest, found := strings.CutPrefix(word, proclitic)
+			if found && utf8.RuneCountInString(rest) >= als.minStemLength && als.findRoot(rest) == root && als.hasTemplateReading(rest) {
+				word, stripped = rest, true
+				break
+			}
+		}
+		if !stripped {
+			break
+		}
+	}
+	return word
+}
+
+// hasTemplateReading checks if the word has a reading without a prefix whose stem matches a template with
+// a dictionary root.
+func (als *ArabicLightStemmer) hasTemplateReading(word string) bool {
+	for _, reading := range als.Analyze(word) {
+		if reading.Prefix == "" && reading.Pattern != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// isQueryVerb checks if an unvocalized query word can only be a conjugated verb, by a verb reading with an
+// imperfect YEH prefix or a subject suffix that belongs to verbs only, e.g. يكتب or كتبوا.
+func (als *ArabicLightStemmer) isQueryVerb(word string) bool {
+	for _, reading := range als.Analyze(word) {
+		if reading.POS != POSVerb || reading.Pattern == "" {
+			continue
+		}
+		if strings.HasSuffix(reading.Prefix, constant.YEH) {
+			return true
+		}
+		switch reading.Suffix {
+		case constant.TEH, constant.WAW + constant.ALEF, constant.TEH + constant.MEEM, constant.TEH + constant.NOON:
+			return true
+		}
+	}
+	return false
+}
+
+// stripPossessive removes the possessive pronoun of an unvocalized noun, restoring a TEH MARBUTA written as TEH,
+// if the noun has the same light stem without it. A final YEH is kept in an adjective, as a nisba ending.
+func (als *ArabicLightStemmer) stripPossessive(word string, adjective bool) (string, string, bool) {
+	for _, pronoun := range possessivePronouns {
+		base, found := strings.CutSuffix(word, pronoun)
+		if !found || utf8.RuneCountInString(base) < als.minStemLength {
+			continue
+		}
+		tehMarbuta := false
+		if teh, ok := strings.CutSuffix(base, constant.TEH); ok && utf8.RuneCountInString(base) >= 4 &&
+			!strings.HasSuffix(base, constant.ALEF+constant.TEH) && !strings.HasSuffix(base, constant.WAW+constant.TEH) {
+			base, tehMarbuta = teh+constant.TEH_MARBUTA, true
+		}
+		// A final YEH is only a pronoun after a noun with a dictionary root. It is a nisba ending in an adjective and
+		// after the nouns nisba adjectives are commonly formed from, e.g. تعليمي, and a root letter in the nisba
+		// exceptions and in the words whose root ends in it, e.g. كرسي or قاضي. A nisba ending replaces a TEH MARBUTA.
+		if pronoun == constant.YEH && !tehMarbuta {
+			if adjective || als.isNisbaBase(base) || utils.Contains(constant.NISBA_EXCEPTIONS, word) {
+				return "", "", false
+			}
+			root, wordRoot := als.findRoot(base), als.findRoot(word)
+			if !als.rootsManager.IsRoot(root) || (strings.HasSuffix(wordRoot, constant.YEH) && wordRoot != root) {
+				return "", "", false
+			}
+		}
+		if als.lightStem(base) == als.lightStem(word) {
+			return base, pronoun, true
+		}
+	}
+	return "", "", false
+}
+
+// isNisbaBase checks if an unvocalized noun matches one of NISBA_BASE_TEMPLATES with a dictionary root.
+func (als *ArabicLightStemmer) isNisbaBase(noun string) bool {
+	for _, p := range nisbaBaseTemplates().Match(noun) {
+		if als.rootsManager.IsRoot(als.rootsManager.NormalizeRoot(p.Root)) {
+			return true
+		}
+	}
+	return false
+}
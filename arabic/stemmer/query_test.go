@@ -0,0 +1,63 @@
+package stemmer
+
+import "testing"
+
+func TestNormalizeQueryPossessiveYeh(t *testing.T) {
+	tests := []struct {
+		word    string
+		term    string
+		pronoun string
+	}{
+		{"كتابي", "كتاب", "ي"},
+		{"بيتي", "بيت", "ي"},
+		{"قلمي", "قلم", "ي"},
+		{"صديقي", "صديق", "ي"},
+		{"سيارتي", "سيارة", "ي"},
+		{"وكتابي", "كتاب", "ي"},
+		{"بكتابه", "كتاب", "ه"},
+		{"تعليمي", "تعليمي", ""},
+		{"قانوني", "قانوني", ""},
+		{"كرسي", "كرسي", ""},
+		{"قاضي", "قاضي", ""},
+	}
+	als := NewArabicLightStemmer()
+	for _, tt := range tests {
+		terms := als.NormalizeQuery(tt.word)
+		if len(terms) != 1 {
+			t.Fatalf("NormalizeQuery(%q) returned %d terms, want 1", tt.word, len(terms))
+		}
+		if terms[0].Term != tt.term || terms[0].Pronoun != tt.pronoun {
+			t.Errorf("NormalizeQuery(%q) = %q with pronoun %q, want %q with pronoun %q",
+				tt.word, terms[0].Term, terms[0].Pronoun, tt.term, tt.pronoun)
+		}
+	}
+}
+
+func TestNormalizeQueryConstruct(t *testing.T) {
+	tests := []struct {
+		query     string
+		terms     []string
+		construct []bool
+	}{
+		{"كتاب الطالب", []string{"كتاب", "طالب"}, []bool{true, false}},
+		{"للطالب", []string{"طالب"}, []bool{false}},
+		{"التزام", []string{"التزام"}, []bool{false}},
+		{"كتاب عربي", []string{"كتاب", "عربي"}, []bool{false, false}},
+		{"يكتب الطالب", []string{"يكتب", "طالب"}, []bool{false, false}},
+		{"ذهب الى المدرسة", []string{"ذهب", "الى", "مدرسة"}, []bool{false, false, false}},
+		{"وزير الخارجية", []string{"وزير", "خارجية"}, []bool{true, false}},
+	}
+	als := NewArabicLightStemmer()
+	for _, tt := range tests {
+		terms := als.NormalizeQuery(tt.query)
+		if len(terms) != len(tt.terms) {
+			t.Fatalf("NormalizeQuery(%q) returned %d terms, want %d", tt.query, len(terms), len(tt.terms))
+		}
+		for i, term := range terms {
+			if term.Term != tt.terms[i] || term.Construct != tt.construct[i] {
+				t.Errorf("NormalizeQuery(%q)[%d] = %q, construct %v, want %q, construct %v",
+					tt.query, i, term.Term, term.Construct, tt.terms[i], tt.construct[i])
+			}
+		}
+	}
+}
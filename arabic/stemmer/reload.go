@@ -0,0 +1,174 @@
+package stemmer
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/dictfile"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DictionaryFiles names the files the stemmer's dictionaries are reloaded from. Empty paths are not reloaded.
+// A file may start with the versioned header written by dictfile.Encode or `arstem seal`, whose version, kind
+// and checksum are then checked before anything is loaded.
+type DictionaryFiles struct {
+	// Stopwords is a JSON file in the format of stop_words/stopwords.json.
+	Stopwords string
+	// Roots, Prefixes and Suffixes are text files with one entry per line. The empty affix is always included.
+	Roots    string
+	Prefixes string
+	Suffixes string
+	// Verbs is a text file with one verb, or precomputed stamp (see stamp.Key), per line.
+	Verbs string
+}
+
+// SetDictionaryFiles sets the files read by Reload and WatchDictionaries.
+// It must be called before WatchDictionaries, and doesn't load anything by itself.
+func (als *ArabicLightStemmer) SetDictionaryFiles(files DictionaryFiles) {
+	als.dictionaryFiles = files
+}
+
+// GetDictionaryFiles returns the files read by Reload and WatchDictionaries.
+func (als *ArabicLightStemmer) GetDictionaryFiles() DictionaryFiles {
+	return als.dictionaryFiles
+}
+
+// Reload reads the dictionary files and replaces the stopwords, roots and affix lists without restarting the service.
+// All files are read before anything is replaced, and each dictionary is swapped in atomically, so words stemmed
+// concurrently never see a partially loaded dictionary. If a file can't be read, the current dictionaries are kept
+// and the returned error wraps ErrDictionaryLoad; an empty roots file yields ErrEmptyRootsList.
+func (als *ArabicLightStemmer) Reload() error {
+	files := als.dictionaryFiles
+	var rootList, prefixList, suffixList, verbList []string
+	var err error
+	if files.Roots != "" {
+		if rootList, err = readLines(files.Roots, dictfile.KindRoots); err != nil {
+			return err
+		}
+		if len(rootList) == 0 {
+			return fmt.Errorf("%w: %s", ErrEmptyRootsList, files.Roots)
+		}
+	}
+	if files.Verbs != "" {
+		if verbList, err = readLines(files.Verbs, dictfile.KindVerbs); err != nil {
+			return err
+		}
+		if len(verbList) == 0 {
+			return fmt.Errorf("%w: %s", ErrEmptyVerbList, files.Verbs)
+		}
+	}
+	if files.Prefixes != "" {
+		if prefixList, err = readLines(files.Prefixes, dictfile.KindPrefixes); err != nil {
+			return err
+		}
+		prefixList = withEmptyAffix(prefixList)
+	}
+	if files.Suffixes != "" {
+		if suffixList, err = readLines(files.Suffixes, dictfile.KindSuffixes); err != nil {
+			return err
+		}
+		suffixList = withEmptyAffix(suffixList)
+	}
+	if files.Stopwords != "" {
+		if err := als.stopWordManager.Reload(files.Stopwords); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrDictionaryLoad, files.Stopwords, err)
+		}
+	}
+
+	if rootList != nil {
+		als.rootsManager.Reload(rootList)
+	}
+	if verbList != nil {
+		als.verbListManager.Reload(verbList)
+	}
+	if prefixList != nil || suffixList != nil {
+		affixes := als.affixes.Load()
+		if prefixList == nil {
+			prefixList = affixes.prefixList
+		}
+		if suffixList == nil {
+			suffixList = affixes.suffixList
+		}
+		als.affixes.Store(newAffixSet(prefixList, suffixList))
+	}
+	return nil
+}
+
+// WatchDictionaries checks the dictionary files for modifications at the given interval, and calls Reload
+// whenever one of them changes. Reload errors are passed to onError, if not nil, and the previous dictionaries
+// stay in use. It returns a function stopping the watch.
+func (als *ArabicLightStemmer) WatchDictionaries(interval time.Duration, onError func(error)) (stop func()) {
+	files := als.dictionaryFiles
+	paths := []string{files.Stopwords, files.Roots, files.Prefixes, files.Suffixes, files.Verbs}
+	modTimes := modificationTimes(paths)
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				current := modificationTimes(paths)
+				if current == modTimes {
+					continue
+				}
+				modTimes = current
+				if err := als.Reload(); err != nil && onError != nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// modificationTimes returns the modification times of the given files in nanoseconds,
+// zero for empty paths and missing files.
+func modificationTimes(paths []string) [5]int64 {
+	var times [5]int64
+	for i, path := range paths {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			times[i] = info.ModTime().UnixNano()
+		}
+	}
+	return times
+}
+
+// readLines reads the non-blank lines of a text file, trimmed of surrounding whitespace, after checking
+// its versioned header, if any, against the kind of dictionary. Errors wrap ErrDictionaryLoad.
+func readLines(path string, kind dictfile.Kind) ([]string, error) {
+	content, err := dictfile.ReadFile(path, kind)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDictionaryLoad, err)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrDictionaryLoad, path, err)
+	}
+	return lines, nil
+}
+
+// withEmptyAffix prepends the empty affix to the list, so that words without an affix can still be segmented.
+func withEmptyAffix(affixes []string) []string {
+	return append([]string{""}, affixes...)
+}
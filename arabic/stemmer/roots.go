@@ -0,0 +1,42 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// NearestRoots returns the dictionary roots within maxDist edits of the given candidate root, closest first.
+// It helps recover from extraction results that aren't in the dictionary because of OCR noise or misspellings.
+func (als *ArabicLightStemmer) NearestRoots(candidate string, maxDist int) []string {
+	return als.rootsManager.NearestRoots(candidate, maxDist)
+}
+
+// RootsWithPrefix returns the dictionary roots starting with the given letters, in lexicographic order,
+// e.g. for autocompletion over the roots dictionary.
+func (als *ArabicLightStemmer) RootsWithPrefix(prefix string) []string {
+	if index, ok := als.rootsManager.(roots.PrefixIndex); ok {
+		return index.RootsWithPrefix(prefix)
+	}
+	prefix = als.rootsManager.NormalizeRoot(prefix)
+	var matches []string
+	for _, root := range als.rootsManager.Roots() {
+		if strings.HasPrefix(root, prefix) {
+			matches = append(matches, root)
+		}
+	}
+	sort.Strings(matches)
+	return matches
+}
+
+// HasRootPrefix reports whether a dictionary root starts with the given letters.
+func (als *ArabicLightStemmer) HasRootPrefix(prefix string) bool {
+	if index, ok := als.rootsManager.(roots.PrefixIndex); ok {
+		return index.HasRootPrefix(prefix)
+	}
+	prefix = als.rootsManager.NormalizeRoot(prefix)
+	return slices.ContainsFunc(als.rootsManager.Roots(), func(root string) bool {
+		return strings.HasPrefix(root, prefix)
+	})
+}
@@ -0,0 +1,95 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+	"sort"
+)
+
+// Segmentation is a split of a word into prefix, stem and suffix whose affix combination has been validated.
+type Segmentation struct {
+	Prefix string
+	Stem   string
+	Suffix string
+	// StarStem is the stem with its root letters replaced by the joker (e.g. يكتب → ي***).
+	StarStem string
+	// Root is the root extracted from this segmentation alone.
+	Root string
+	// Verb and Noun report whether the segmentation is valid as a conjugated verb and as a noun respectively.
+	Verb bool
+	Noun bool
+	// Confidence is set by StemSegmentation, see Confidence.
+	Confidence float64
+}
+
+// SegmentAll returns every segmentation of the word whose affixes are valid for a verb or a noun,
+// rather than only the one chosen by LightStem. Segmentations are ordered by increasing prefix length,
+// then increasing stem length. Stopwords are not segmented, and yield nil.
+func (als *ArabicLightStemmer) SegmentAll(word string) []Segmentation {
+	word = als.prepareWord(word)
+	if word == "" || als.IsStopword(word) {
+		return nil
+	}
+	_, _, stemLeft, stemRight := als.transform2Stars(word)
+	segmentList, unvocalized, _, _ := als.segment(word)
+	runeWord := []rune(unvocalized)
+
+	var segmentations []Segmentation
+	for _, segment := range sortedSegments(segmentList) {
+		left, right := segment[0], segment[1]
+		if right > len(runeWord) {
+			continue
+		}
+		prefix := string(runeWord[:left])
+		stem := string(runeWord[left:right])
+		suffix := string(runeWord[right:])
+		isVerb := als.validAffix(affix.TagVerb, prefix, suffix, stem)
+		isNoun := als.validAffix(affix.TagNoun, prefix, suffix, stem)
+		if !isVerb && !isNoun {
+			continue
+		}
+		tuple := als.getAffixTuple(word, unvocalized, "", left, right, stemLeft, stemRight, left, right, segmentList)
+		segmentations = append(segmentations, Segmentation{
+			Prefix:   prefix,
+			Stem:     stem,
+			Suffix:   suffix,
+			StarStem: tuple["starstem"],
+			Root:     tuple["root"],
+			Verb:     isVerb,
+			Noun:     isNoun,
+		})
+	}
+	return segmentations
+}
+
+// StemSegmentation returns the segmentation of the word chosen by LightStem, with the root given by Root.
+// When the stem isn't that of a valid segmentation, e.g. after hamza restoration or spelling correction,
+// the prefix, suffix and star stem are left empty rather than guessed.
+func (als *ArabicLightStemmer) StemSegmentation(word string) Segmentation {
+	stem, confidence := als.StemWithConfidence(word)
+	root := als.Root(word)
+	for _, segmentation := range als.SegmentAll(word) {
+		if segmentation.Stem == stem {
+			segmentation.Root = root
+			segmentation.Confidence = confidence
+			return segmentation
+		}
+	}
+	return Segmentation{Stem: stem, Root: root, Confidence: confidence}
+}
+
+// sortedSegments flattens a segment list into segments ordered by increasing left index, then increasing right index.
+func sortedSegments(segmentList map[int][][2]int) [][2]int {
+	var segments [][2]int
+	for left, pairs := range segmentList {
+		for _, pair := range pairs {
+			segments = append(segments, [2]int{left, pair[1]})
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i][0] != segments[j][0] {
+			return segments[i][0] < segments[j][0]
+		}
+		return segments[i][1] < segments[j][1]
+	})
+	return segments
+}
@@ -0,0 +1,30 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/sentence"
+)
+
+// SentenceToken is a token of a text, with its light stem and the index of the sentence it belongs to.
+type SentenceToken struct {
+	Sentence int    `json:"sentence"`
+	Token    string `json:"token"`
+	Stem     string `json:"stem"`
+}
+
+// SplitSentences splits the text into sentences on Arabic and Latin terminal punctuation and paragraph breaks,
+// leaving periods of common abbreviations (د. ، ص. ، Dr.) and numbers within their sentence.
+func (als *ArabicLightStemmer) SplitSentences(text string) []sentence.Sentence {
+	return als.sentenceSplitter.Split(text)
+}
+
+// StemSentences tokenizes and stems the text sentence by sentence, reporting for each token the index of its sentence.
+// Tokens are returned in text order, so the tokens of a sentence are contiguous.
+func (als *ArabicLightStemmer) StemSentences(text string) []SentenceToken {
+	var tokens []SentenceToken
+	for _, s := range als.SplitSentences(text) {
+		for _, token := range als.Tokenize(s.Text) {
+			tokens = append(tokens, SentenceToken{Sentence: s.Index, Token: token, Stem: als.LightStem(token)})
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,81 @@
+package stemmer
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// ShortWordPolicy controls how LightStem and Root handle words of at most the short word length,
+// whose segmentation is often wrong, e.g. بيت stemmed to ي.
+type ShortWordPolicy int
+
+const (
+	// ShortWordStem stems short words like any other word. This is the default.
+	ShortWordStem ShortWordPolicy = iota
+	// ShortWordKeep returns short words unvocalized as both their stem and their root.
+	ShortWordKeep
+	// ShortWordParticle treats short words as particles: they are their own stem and have no root.
+	ShortWordParticle
+	// ShortWordStopwordOnly only looks short words up in the stopwords list, returning the stopword stem
+	// of those found, and keeps the others unvocalized as their stem and root.
+	ShortWordStopwordOnly
+)
+
+// SetShortWordPolicy sets how words of at most the short word length are stemmed.
+// It returns ErrInvalidOption if the policy is unknown.
+func (als *ArabicLightStemmer) SetShortWordPolicy(policy ShortWordPolicy) error {
+	if policy < ShortWordStem || policy > ShortWordStopwordOnly {
+		return fmt.Errorf("%w: short word policy %d", ErrInvalidOption, policy)
+	}
+	als.shortWordPolicy = policy
+	return nil
+}
+
+// GetShortWordPolicy returns how words of at most the short word length are stemmed.
+// The default is ShortWordStem.
+func (als *ArabicLightStemmer) GetShortWordPolicy() ShortWordPolicy {
+	return als.shortWordPolicy
+}
+
+// SetShortWordLength sets the maximum number of letters of the words handled by the short word policy.
+// It returns ErrInvalidLength, leaving the length unchanged, if the new length is lower than 1.
+func (als *ArabicLightStemmer) SetShortWordLength(length int) error {
+	if length < 1 {
+		return fmt.Errorf("%w: short word length %d", ErrInvalidLength, length)
+	}
+	als.shortWordLength = length
+	return nil
+}
+
+// GetShortWordLength returns the maximum number of letters of the words handled by the short word policy.
+// The default is 3.
+func (als *ArabicLightStemmer) GetShortWordLength() int {
+	return als.shortWordLength
+}
+
+// shortWord returns the stem and root of a prepared word when the short word policy applies to it.
+func (als *ArabicLightStemmer) shortWord(word string) (stem, root string, ok bool) {
+	if als.shortWordPolicy == ShortWordStem {
+		return "", "", false
+	}
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	if utf8.RuneCountInString(unvocalized) > als.shortWordLength {
+		return "", "", false
+	}
+	switch als.shortWordPolicy {
+	case ShortWordParticle:
+		return unvocalized, "", true
+	case ShortWordStopwordOnly:
+		if als.IsStopword(word) {
+			stem := als.stopWordManager.StopStem(word)
+			if stem == "" {
+				stem = als.stopWordManager.StopStem(unvocalized)
+			}
+			if stem == "" {
+				stem = unvocalized
+			}
+			return stem, stem, true
+		}
+	}
+	return unvocalized, unvocalized, true
+}
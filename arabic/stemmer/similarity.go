@@ -0,0 +1,125 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"math"
+	"unicode/utf8"
+)
+
+// SimilarityMeasure is the measure used by SimilarityWith to compare the terms of two documents.
+type SimilarityMeasure int
+
+const (
+	// SimilarityCosine is the cosine of the term count vectors of the documents. It is the default.
+	SimilarityCosine SimilarityMeasure = iota
+	// SimilarityJaccard is the weighted Jaccard index of the term multisets: the sum of the smaller counts
+	// of every term divided by the sum of the larger ones.
+	SimilarityJaccard
+)
+
+// SimilarityTerms selects what the terms of the documents compared by SimilarityWith are.
+type SimilarityTerms int
+
+const (
+	// TermsStem compares the normalized light stems of the words. It is the default.
+	TermsStem SimilarityTerms = iota
+	// TermsRoot compares the roots of the words, matching more distant derivations. Words without a root are left out.
+	TermsRoot
+)
+
+// SimilarityOptions configures a SimilarityWith call. The zero value compares stems with the cosine measure.
+type SimilarityOptions struct {
+	Measure SimilarityMeasure
+	Terms   SimilarityTerms
+}
+
+// SameRoot checks if two words share the same extracted root, such as يكتبون and كتب.
+// Words without an extractable root never share a root.
+func (als *ArabicLightStemmer) SameRoot(a, b string) bool {
+	rootA := als.normalizeRoot(als.Root(a))
+	return rootA != "" && rootA == als.normalizeRoot(als.Root(b))
+}
+
+// RootSimilarity returns a similarity score between 0 and 1 for two words, based on their roots and normalized stems.
+// Words sharing a root or a normalized stem score 1; otherwise the score averages the edit distance similarity
+// of the roots and of the stems, so that related but distinct words still score higher than unrelated ones.
+func (als *ArabicLightStemmer) RootSimilarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	if als.SameRoot(a, b) {
+		return 1
+	}
+	stemA := als.NormalizeSearchText(als.LightStem(a))
+	stemB := als.NormalizeSearchText(als.LightStem(b))
+	if stemA != "" && stemA == stemB {
+		return 1
+	}
+	rootScore := editSimilarity(als.normalizeRoot(als.Root(a)), als.normalizeRoot(als.Root(b)))
+	stemScore := editSimilarity(stemA, stemB)
+	return (rootScore + stemScore) / 2
+}
+
+// editSimilarity converts the edit distance between two strings into a similarity between 0 and 1.
+func editSimilarity(a, b string) float64 {
+	length := utils.Max(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+	if length == 0 {
+		return 0
+	}
+	return 1 - float64(utils.Levenshtein(a, b))/float64(length)
+}
+
+// Similarity returns the similarity of two documents between 0 and 1, the cosine of their stem count vectors
+// with stopwords left out. Documents without any term have a similarity of 0. See SimilarityWith for the other
+// measures and for roots.
+func (als *ArabicLightStemmer) Similarity(docA, docB string) float64 {
+	return als.SimilarityWith(docA, docB, SimilarityOptions{})
+}
+
+// SimilarityWith returns the similarity of two documents between 0 and 1, comparing the multisets of their
+// stems or roots, stopwords left out, with the measure of the options.
+func (als *ArabicLightStemmer) SimilarityWith(docA, docB string, options SimilarityOptions) float64 {
+	termsA, termsB := als.documentTerms(docA, options.Terms), als.documentTerms(docB, options.Terms)
+	if len(termsA) == 0 || len(termsB) == 0 {
+		return 0
+	}
+	if options.Measure == SimilarityJaccard {
+		shared, total := 0, 0
+		for term, countA := range termsA {
+			shared += min(countA, termsB[term])
+			total += max(countA, termsB[term])
+		}
+		for term, countB := range termsB {
+			if _, exists := termsA[term]; !exists {
+				total += countB
+			}
+		}
+		return float64(shared) / float64(total)
+	}
+	dot, normA, normB := 0.0, 0.0, 0.0
+	for term, countA := range termsA {
+		dot += float64(countA * termsB[term])
+		normA += float64(countA * countA)
+	}
+	for _, countB := range termsB {
+		normB += float64(countB * countB)
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// documentTerms counts the stems or roots of the words of a document that aren't stopwords or in stop phrases.
+func (als *ArabicLightStemmer) documentTerms(document string, terms SimilarityTerms) map[string]int {
+	counts := make(map[string]int)
+	for _, token := range als.RemoveStopwords(als.Tokenize(document)) {
+		var term string
+		if terms == TermsRoot {
+			term = als.normalizeRoot(als.Root(token))
+		} else {
+			term = als.NormalizeSearchText(als.LightStem(token))
+		}
+		if term != "" {
+			counts[term]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,59 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/spelling"
+	"unicode/utf8"
+)
+
+// correctSpelling returns the word, or a corrected variant of it, whose root is found in the roots dictionary.
+// Words that are stopwords, loanwords or have a segmentation yielding a dictionary root are left as they are.
+// The common error corrections are tried first, then the single-edit variants, substitutions before deletions.
+// A word taking a prefix or a suffix is never shortened, e.g. برلمان isn't read as بلمان. If no variant yields
+// a dictionary root, the word is returned unchanged.
+func (als *ArabicLightStemmer) correctSpelling(word string) string {
+	if als.hasDictionaryRoot(word) {
+		return word
+	}
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	for _, variant := range spelling.Corrections(unvocalized) {
+		if als.hasDictionaryRoot(variant) {
+			return variant
+		}
+	}
+	affixed, length := als.isAffixed(unvocalized), utf8.RuneCountInString(unvocalized)
+	for _, variant := range spelling.Edits(unvocalized) {
+		if affixed && utf8.RuneCountInString(variant) < length {
+			continue
+		}
+		if als.hasDictionaryRoot(variant) {
+			return variant
+		}
+	}
+	return word
+}
+
+// hasDictionaryRoot checks if the word is a stopword, a loanword or has a valid segmentation yielding
+// a dictionary root.
+func (als *ArabicLightStemmer) hasDictionaryRoot(word string) bool {
+	if als.stopWordManager.IsStopword(word) {
+		return true
+	}
+	if als.loanwordDetector.IsLoanword(als.wordProcessor.StripTashkeel(word)) {
+		return true
+	}
+	return als.rootsManager.IsRoot(als.findRoot(word))
+}
+
+// isAffixed checks if an unvocalized word has a segmentation with a prefix or a suffix.
+func (als *ArabicLightStemmer) isAffixed(unvocalized string) bool {
+	segmentList, _, _, _ := als.segment(unvocalized)
+	length := utf8.RuneCountInString(unvocalized)
+	for _, segments := range segmentList {
+		for _, segment := range segments {
+			if segment[0] > 0 || segment[1] < length {
+				return true
+			}
+		}
+	}
+	return false
+}
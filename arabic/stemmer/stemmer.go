@@ -1,40 +1,110 @@
 package stemmer
 
 import (
+	"context"
 	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/arabizi"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/chars"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/comparative"
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/diminutive"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/geminate"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/hamza"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/loanword"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/lucene"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/ngram"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/nisba"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/pattern"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/plural"
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/sentence"
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stamp"
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stop_words"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/tokenizer"
 	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/weak"
 	"regexp"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"unicode/utf8"
 )
 
 // ArabicLightStemmer defines a stemmer with configurable parameters.
 type ArabicLightStemmer struct {
-	stopWordManager  stop_words.StopwordManager
-	wordProcessor    stop_words.WordProcessor
-	tashkeelChecker  stop_words.TashkeelChecker
-	verbListManager  stamp.VerbListManager
-	verbNormalizer   stamp.VerbNormalizer
-	rootsManager     roots.RootsManager
-	prefixLetters    string
-	suffixLetters    string
-	infixLetters     string
-	maxPrefixLength  int
-	maxSuffixLength  int
-	minStemLength    int
-	joker            string
-	prefixList       []string
-	suffixList       []string
-	rootList         []string
-	validAffixesList []string
-	tokenPat         *regexp.Regexp
-	prefixesTree     map[string]interface{}
-	suffixesTree     map[string]interface{}
+	stopWordManager       stop_words.StopwordManager
+	wordProcessor         stop_words.WordProcessor
+	tashkeelChecker       stop_words.TashkeelChecker
+	verbListManager       stamp.VerbListManager
+	verbNormalizer        stamp.VerbNormalizer
+	rootsManager          roots.RootsManager
+	patternMatcher        pattern.PatternMatcher
+	pluralResolver        plural.PluralResolver
+	nisbaAnalyzer         nisba.NisbaAnalyzer
+	comparativeAnalyzer   comparative.ComparativeAnalyzer
+	diminutiveAnalyzer    diminutive.DiminutiveAnalyzer
+	weakRootResolver      weak.WeakRootResolver
+	loanwordDetector      loanword.LoanwordDetector
+	arabiziConverter      arabizi.ArabiziConverter
+	expansionIndex        map[string][]string
+	stripNisba            bool
+	stripComparativeHamza bool
+	geminationRules       geminate.Rules
+	quadPolicy            roots.QuadriliteralPolicy
+	restoreHamza          bool
+	skipLoanwords         bool
+	convertArabizi        bool
+	spellingTolerant      bool
+	luceneCompatible      bool
+	segmentationStrategy  SegmentationStrategy
+	engine                Engine
+	alefWasla             normalize.AlefTreatment
+	daggerAlef            normalize.AlefTreatment
+	tehMarbuta            normalize.TehMarbutaPolicy
+	alefMaksura           normalize.AlefMaksuraPolicy
+	hamzaLevel            normalize.HamzaLevel
+	hashtagAware          bool
+	affixTag              string
+	requireDictionaryRoot bool
+	protectedWords        utils.Set[string]
+	stopPhrases           *utils.Trie[string]
+	stopPhraseList        []string
+	expressions           *utils.Trie[string]
+	expressionKeys        map[string]string
+	prefixLetters         string
+	suffixLetters         string
+	infixLetters          string
+	letterClasses         letterClasses
+	maxPrefixLength       int
+	maxSuffixLength       int
+	minStemLength         int
+	joker                 string
+	validAffixesList      []string
+	affixRules            affix.RuleSet
+	affixWeights          affix.Weights
+	languageModel         ngram.Model
+	fallbackOriginal      float64
+	shortWordPolicy       ShortWordPolicy
+	shortWordLength       int
+	feminineBase          plural.FeminineBase
+	hooks                 Hooks
+	tracer                Tracer
+	traceContext          context.Context
+	tokenizer             textTokenizer
+	tokenPolicies         map[tokenizer.TokenType]TokenPolicy
+	sentenceSplitter      sentence.Splitter
+	affixes               *atomic.Pointer[affixSet]
+	dictionaryFiles       DictionaryFiles
+}
+
+// affixSet holds the prefix and suffix lists with their lookup trees, replaced as a whole when they change.
+type affixSet struct {
+	prefixList   []string
+	suffixList   []string
+	prefixesTree *utils.Trie[rune]
+	suffixesTree *utils.Trie[rune]
 }
 
 // NewArabicLightStemmer creates a new instance of ArabicLightStemmer with default values.
@@ -48,40 +118,69 @@ func NewArabicLightStemmer() *ArabicLightStemmer {
 	verbNormalizer := stamp.NewVerbNormalizer(wordProcessor)
 	verbListManager := stamp.NewVerbListManager(stamp.INITIAL_VERB_LIST, verbNormalizer)
 	rootsManager := roots.NewRootsManager()
+	patternMatcher := pattern.NewPatternMatcher(constant.DEFAULT_PATTERN_LIST)
+	pluralResolver := plural.NewPluralResolver(constant.BROKEN_PLURAL_TEMPLATES, constant.BROKEN_PLURAL_EXCEPTIONS, rootsManager)
+	nisbaAnalyzer := nisba.NewNisbaAnalyzer(constant.NISBA_EXCEPTIONS, rootsManager, constant.DEFAULT_MIN_STEM)
+	weakRootResolver := weak.NewWeakRootResolver(weak.DefaultPolicy(), rootsManager, constant.DEFAULT_JOKER)
+	loanwordDetector := loanword.NewLoanwordDetector(constant.LOANWORDS, constant.LOANWORD_MORPHEMES, constant.DEFAULT_LOANWORD_THRESHOLD)
 	stemmer := &ArabicLightStemmer{
-		stopWordManager:  stopWordManager,
-		wordProcessor:    wordProcessor,
-		tashkeelChecker:  tashkeelChecker,
-		verbListManager:  verbListManager,
-		verbNormalizer:   verbNormalizer,
-		rootsManager:     rootsManager,
-		prefixLetters:    constant.DEFAULT_PREFIX_LETTERS,
-		suffixLetters:    constant.DEFAULT_SUFFIX_LETTERS,
-		infixLetters:     constant.DEFAULT_INFIX_LETTERS,
-		maxPrefixLength:  constant.DEFAULT_MAX_PREFIX,
-		maxSuffixLength:  constant.DEFAULT_MAX_SUFFIX,
-		minStemLength:    constant.DEFAULT_MIN_STEM,
-		joker:            constant.DEFAULT_JOKER,
-		prefixList:       constant.DEFAULT_PREFIX_LIST,
-		suffixList:       constant.DEFAULT_SUFFIX_LIST,
-		rootList:         constant.ROOTS,
-		validAffixesList: affixList,
-		tokenPat:         regexp.MustCompile(`[^\w\x{064b}-\x{0652}']+`),
-		prefixesTree:     make(map[string]interface{}),
-		suffixesTree:     make(map[string]interface{}),
+		stopWordManager:     stopWordManager,
+		wordProcessor:       wordProcessor,
+		tashkeelChecker:     tashkeelChecker,
+		verbListManager:     verbListManager,
+		verbNormalizer:      verbNormalizer,
+		rootsManager:        rootsManager,
+		patternMatcher:      patternMatcher,
+		pluralResolver:      pluralResolver,
+		nisbaAnalyzer:       nisbaAnalyzer,
+		comparativeAnalyzer: comparative.NewComparativeAnalyzer(constant.COMPARATIVES, rootsManager),
+		diminutiveAnalyzer:  diminutive.NewDiminutiveAnalyzer(constant.DIMINUTIVES, rootsManager),
+		weakRootResolver:    weakRootResolver,
+		loanwordDetector:    loanwordDetector,
+		arabiziConverter:    arabizi.NewArabiziConverter(constant.DEFAULT_ARABIZI_MAPPING),
+		expansionIndex:      make(map[string][]string),
+		protectedWords:      utils.NewSet[string](),
+		alefWasla:           normalize.AlefToPlain,
+		daggerAlef:          normalize.AlefStrip,
+		hamzaLevel:          normalize.HamzaFull,
+		geminationRules:     geminate.DefaultRules(),
+		quadPolicy:          roots.QuadriliteralAllow,
+		prefixLetters:       constant.DEFAULT_PREFIX_LETTERS,
+		suffixLetters:       constant.DEFAULT_SUFFIX_LETTERS,
+		infixLetters:        constant.DEFAULT_INFIX_LETTERS,
+		maxPrefixLength:     constant.DEFAULT_MAX_PREFIX,
+		maxSuffixLength:     constant.DEFAULT_MAX_SUFFIX,
+		minStemLength:       constant.DEFAULT_MIN_STEM,
+		joker:               constant.DEFAULT_JOKER,
+		validAffixesList:    affixList,
+		affixRules:          affix.NewRuleSet(affix.DefaultRules()),
+		affixWeights:        affix.DefaultWeights(),
+		shortWordLength:     constant.DEFAULT_SHORT_WORD,
+		tokenizer:           tokenizer.NewTokenizer().(textTokenizer),
+		tokenPolicies:       DefaultTokenPolicies(),
+		sentenceSplitter:    sentence.NewSplitter(constant.DEFAULT_ABBREVIATIONS),
+		affixes:             new(atomic.Pointer[affixSet]),
 	}
 
+	stemmer.letterClasses = newLetterClasses(stemmer.prefixLetters, stemmer.suffixLetters, stemmer.infixLetters)
+	stemmer.SetStopPhrases(constant.DEFAULT_STOP_PHRASES)
+
 	// Initialize prefix and suffix trees
-	stemmer.prefixesTree = stemmer.createPrefixTree()
-	stemmer.suffixesTree = stemmer.createSuffixTree()
+	stemmer.affixes.Store(newAffixSet(constant.DEFAULT_PREFIX_LIST, constant.DEFAULT_SUFFIX_LIST))
 
 	return stemmer
 }
 
 // SetPrefixLetters sets the prefix letters used in the stemming process.
 // The prefix letters define the characters or sequences of characters that may appear at the beginning of words.
-func (als *ArabicLightStemmer) SetPrefixLetters(newPrefixLetters string) {
+// It returns ErrEmptyLetters, leaving the letters unchanged, if newPrefixLetters is empty.
+func (als *ArabicLightStemmer) SetPrefixLetters(newPrefixLetters string) error {
+	if newPrefixLetters == "" {
+		return fmt.Errorf("%w: prefix letters", ErrEmptyLetters)
+	}
 	als.prefixLetters = newPrefixLetters
+	als.letterClasses = newLetterClasses(als.prefixLetters, als.suffixLetters, als.infixLetters)
+	return nil
 }
 
 // GetPrefixLetters returns the current prefix letters used in the stemming process.
@@ -92,8 +191,14 @@ func (als *ArabicLightStemmer) GetPrefixLetters() string {
 
 // SetSuffixLetters sets the suffix letters used in the stemming process.
 // The suffix letters define the characters or sequences of characters that may appear at the end of words.
-func (als *ArabicLightStemmer) SetSuffixLetters(newSuffixLetters string) {
+// It returns ErrEmptyLetters, leaving the letters unchanged, if newSuffixLetters is empty.
+func (als *ArabicLightStemmer) SetSuffixLetters(newSuffixLetters string) error {
+	if newSuffixLetters == "" {
+		return fmt.Errorf("%w: suffix letters", ErrEmptyLetters)
+	}
 	als.suffixLetters = newSuffixLetters
+	als.letterClasses = newLetterClasses(als.prefixLetters, als.suffixLetters, als.infixLetters)
+	return nil
 }
 
 // GetSuffixLetters returns the current suffix letters used in the stemming process.
@@ -106,6 +211,7 @@ func (als *ArabicLightStemmer) GetSuffixLetters() string {
 // Infix letters are characters or sequences of characters that may appear within the root of a word, not at the edges.
 func (als *ArabicLightStemmer) SetInfixLetters(newInfixLetters string) {
 	als.infixLetters = newInfixLetters
+	als.letterClasses = newLetterClasses(als.prefixLetters, als.suffixLetters, als.infixLetters)
 }
 
 // GetInfixLetters returns the current infix letters used in the stemming process.
@@ -116,12 +222,14 @@ func (als *ArabicLightStemmer) GetInfixLetters() string {
 
 // SetJoker sets the joker character used in the stemming process.
 // The joker character is typically used as a wildcard to represent any letter in certain stemming operations.
-func (als *ArabicLightStemmer) SetJoker(newJoker string) {
-	// Ensure that the joker character is only one character long.
-	if len(newJoker) > 1 {
-		newJoker = newJoker[:1]
+// It returns ErrInvalidJoker, leaving the joker unchanged, if newJoker is not exactly one character.
+func (als *ArabicLightStemmer) SetJoker(newJoker string) error {
+	if utf8.RuneCountInString(newJoker) != 1 {
+		return fmt.Errorf("%w: %q", ErrInvalidJoker, newJoker)
 	}
 	als.joker = newJoker
+	als.weakRootResolver = weak.NewWeakRootResolver(als.weakRootResolver.Policy(), als.rootsManager, als.joker)
+	return nil
 }
 
 // GetJoker returns the current joker character used in the stemming process.
@@ -132,8 +240,13 @@ func (als *ArabicLightStemmer) GetJoker() string {
 
 // SetMaxPrefixLength sets the maximum length for prefixes during the stemming process.
 // This value limits how long a prefix can be when identifying and removing prefixes from words.
-func (als *ArabicLightStemmer) SetMaxPrefixLength(newMaxPrefixLength int) {
+// It returns ErrInvalidLength, leaving the length unchanged, if the new length is lower than 1.
+func (als *ArabicLightStemmer) SetMaxPrefixLength(newMaxPrefixLength int) error {
+	if newMaxPrefixLength < 1 {
+		return fmt.Errorf("%w: maximum prefix length %d", ErrInvalidLength, newMaxPrefixLength)
+	}
 	als.maxPrefixLength = newMaxPrefixLength
+	return nil
 }
 
 // GetMaxPrefixLength returns the current maximum length for prefixes used in the stemming process.
@@ -144,8 +257,13 @@ func (als *ArabicLightStemmer) GetMaxPrefixLength() int {
 
 // SetMaxSuffixLength sets the maximum length for suffixes during the stemming process.
 // This value limits how long a suffix can be when identifying and removing suffixes from words.
-func (als *ArabicLightStemmer) SetMaxSuffixLength(newMaxSuffixLength int) {
+// It returns ErrInvalidLength, leaving the length unchanged, if the new length is lower than 1.
+func (als *ArabicLightStemmer) SetMaxSuffixLength(newMaxSuffixLength int) error {
+	if newMaxSuffixLength < 1 {
+		return fmt.Errorf("%w: maximum suffix length %d", ErrInvalidLength, newMaxSuffixLength)
+	}
 	als.maxSuffixLength = newMaxSuffixLength
+	return nil
 }
 
 // GetMaxSuffixLength returns the current maximum length for suffixes used in the stemming process.
@@ -156,8 +274,13 @@ func (als *ArabicLightStemmer) GetMaxSuffixLength() int {
 
 // SetMinStemLength sets the minimum length for the stem after removing prefixes and suffixes.
 // This value ensures that the resulting stem is not shorter than a certain length, which could lead to incorrect results.
-func (als *ArabicLightStemmer) SetMinStemLength(newMinStemLength int) {
+// It returns ErrInvalidLength, leaving the length unchanged, if the new length is lower than 1.
+func (als *ArabicLightStemmer) SetMinStemLength(newMinStemLength int) error {
+	if newMinStemLength < 1 {
+		return fmt.Errorf("%w: minimum stem length %d", ErrInvalidLength, newMinStemLength)
+	}
 	als.minStemLength = newMinStemLength
+	return nil
 }
 
 // GetMinStemLength returns the current minimum length for the stem used in the stemming process.
@@ -168,42 +291,85 @@ func (als *ArabicLightStemmer) GetMinStemLength() int {
 
 // SetPrefixList sets the list of possible prefixes used during the stemming process.
 // This list contains the specific prefixes that the stemmer will look for when processing words.
-func (als *ArabicLightStemmer) SetPrefixList(newPrefixList []string) {
-	als.prefixList = newPrefixList
+// It returns ErrEmptyAffixList, leaving the list unchanged, if newPrefixList is empty.
+func (als *ArabicLightStemmer) SetPrefixList(newPrefixList []string) error {
+	if len(newPrefixList) == 0 {
+		return fmt.Errorf("%w: prefix list", ErrEmptyAffixList)
+	}
 	// Recreate the prefix tree based on the new prefix list.
-	als.createPrefixTree()
+	als.affixes.Store(newAffixSet(newPrefixList, als.affixes.Load().suffixList))
+	return nil
 }
 
 // GetPrefixList returns the current list of prefixes used in the stemming process.
 // The stemmer uses this list to identify and remove prefixes from words.
 func (als *ArabicLightStemmer) GetPrefixList() []string {
-	return als.prefixList
+	return als.affixes.Load().prefixList
 }
 
 // SetSuffixList sets the list of possible suffixes used during the stemming process.
 // This list contains the specific suffixes that the stemmer will look for when processing words.
-func (als *ArabicLightStemmer) SetSuffixList(newSuffixList []string) {
-	als.suffixList = newSuffixList
+// It returns ErrEmptyAffixList, leaving the list unchanged, if newSuffixList is empty.
+func (als *ArabicLightStemmer) SetSuffixList(newSuffixList []string) error {
+	if len(newSuffixList) == 0 {
+		return fmt.Errorf("%w: suffix list", ErrEmptyAffixList)
+	}
 	// Recreate the suffix tree based on the new suffix list.
-	als.createSuffixTree()
+	als.affixes.Store(newAffixSet(als.affixes.Load().prefixList, newSuffixList))
+	return nil
 }
 
 // GetSuffixList returns the current list of suffixes used in the stemming process.
 // The stemmer uses this list to identify and remove suffixes from words.
 func (als *ArabicLightStemmer) GetSuffixList() []string {
-	return als.suffixList
+	return als.affixes.Load().suffixList
 }
 
 // SetRootsList sets the list of known roots used during the stemming process.
 // This list contains the valid roots that the stemmer will check against when processing words.
-func (als *ArabicLightStemmer) SetRootsList(newRootsList []string) {
-	als.rootList = newRootsList
+// A new dictionary is built, so clones sharing the previous dictionary are not affected.
+// It returns ErrEmptyRootsList, leaving the dictionary unchanged, if newRootsList is empty.
+func (als *ArabicLightStemmer) SetRootsList(newRootsList []string) error {
+	if len(newRootsList) == 0 {
+		return ErrEmptyRootsList
+	}
+	rootsManager := roots.NewRootsManager().WithPolicy(als.rootsManager.Policy())
+	rootsManager.Reload(newRootsList)
+	als.setRootsManager(rootsManager)
+	return nil
+}
+
+// setRootsManager replaces the roots manager, along with the resolvers looking roots up in it.
+func (als *ArabicLightStemmer) setRootsManager(rootsManager roots.RootsManager) {
+	als.rootsManager = rootsManager
+	als.pluralResolver = plural.NewPluralResolver(constant.BROKEN_PLURAL_TEMPLATES, constant.BROKEN_PLURAL_EXCEPTIONS, rootsManager)
+	als.nisbaAnalyzer = nisba.NewNisbaAnalyzer(constant.NISBA_EXCEPTIONS, rootsManager, constant.DEFAULT_MIN_STEM)
+	als.comparativeAnalyzer = comparative.NewComparativeAnalyzer(constant.COMPARATIVES, rootsManager)
+	als.diminutiveAnalyzer = diminutive.NewDiminutiveAnalyzer(constant.DIMINUTIVES, rootsManager)
+	als.weakRootResolver = weak.NewWeakRootResolver(als.weakRootResolver.Policy(), rootsManager, als.joker)
 }
 
 // GetRootsList returns the current list of known roots used in the stemming process.
 // The stemmer uses this list to verify whether a stem is a valid root.
 func (als *ArabicLightStemmer) GetRootsList() []string {
-	return als.rootList
+	return als.rootsManager.Roots()
+}
+
+// SetVerbList sets the list of verbs whose stamps validate verb stems during segmentation.
+// The verbs are normalized with stamp.Key, so precomputed stamps can be given as well. A new list is built,
+// so clones sharing the previous list are not affected. It returns ErrEmptyVerbList if newVerbList is empty.
+func (als *ArabicLightStemmer) SetVerbList(newVerbList []string) error {
+	if len(newVerbList) == 0 {
+		return ErrEmptyVerbList
+	}
+	als.verbListManager = stamp.NewVerbListManager(newVerbList, als.verbNormalizer)
+	return nil
+}
+
+// GetVerbStamps returns the stamps of the verb list in use, in lexicographic order.
+// Stamps are the normalized forms computed by stamp.Key, and can be passed back to SetVerbList.
+func (als *ArabicLightStemmer) GetVerbStamps() []string {
+	return als.verbListManager.Stamps()
 }
 
 // SetValidAffixesList sets the list of valid affixes (combinations of prefixes and suffixes) used during the stemming process.
@@ -218,61 +384,316 @@ func (als *ArabicLightStemmer) GetValidAffixesList() []string {
 	return als.validAffixesList
 }
 
+// SetStripNisba enables or disables stripping of nisba endings (ي/ية and their inflections) during stemming.
+// When enabled, nisba adjectives are reduced to their base noun, e.g. العراقيون → عراق.
+func (als *ArabicLightStemmer) SetStripNisba(stripNisba bool) {
+	als.stripNisba = stripNisba
+}
+
+// GetStripNisba returns whether nisba endings are stripped during stemming.
+// It is disabled by default, which keeps the stemmer's original suffix handling.
+func (als *ArabicLightStemmer) GetStripNisba() bool {
+	return als.stripNisba
+}
+
+// SetWeakRootPolicy sets the policy used to reconstruct hollow, defective and assimilated roots.
+// The policy selects which classes of weak roots are completed and whether candidates are confirmed against the roots dictionary.
+func (als *ArabicLightStemmer) SetWeakRootPolicy(policy weak.Policy) {
+	als.weakRootResolver = weak.NewWeakRootResolver(policy, als.rootsManager, als.joker)
+}
+
+// GetWeakRootPolicy returns the current policy used to reconstruct weak roots.
+// By default every class of weak root is reconstructed and confirmed against the roots dictionary.
+func (als *ArabicLightStemmer) GetWeakRootPolicy() weak.Policy {
+	return als.weakRootResolver.Policy()
+}
+
+// SetGeminationRules sets the rules used to handle geminated (doubled) roots such as مدد and ردد.
+// The rules control shadda expansion before root extraction and the completion of two-letter roots.
+func (als *ArabicLightStemmer) SetGeminationRules(rules geminate.Rules) {
+	als.geminationRules = rules
+}
+
+// GetGeminationRules returns the current rules used to handle geminated roots.
+// By default shadda is expanded and two-letter roots are doubled when no weak root reading is known.
+func (als *ArabicLightStemmer) GetGeminationRules() geminate.Rules {
+	return als.geminationRules
+}
+
+// SetQuadriliteralPolicy sets how four-letter roots compete with triliteral roots during root extraction.
+// The policy can allow them as ordinary candidates, prefer them when found in the dictionary, or exclude them.
+func (als *ArabicLightStemmer) SetQuadriliteralPolicy(policy roots.QuadriliteralPolicy) {
+	als.quadPolicy = policy
+}
+
+// GetQuadriliteralPolicy returns the current policy for four-letter roots.
+// By default four-letter roots are accepted alongside triliteral roots without preference.
+func (als *ArabicLightStemmer) GetQuadriliteralPolicy() roots.QuadriliteralPolicy {
+	return als.quadPolicy
+}
+
+// SetRootPolicy sets the policy defining the valid candidate roots, by their number of letters and their letters.
+// It applies both to the choice of the root by the stemmer and to the filtering of candidates by the roots manager.
+// The roots dictionary is still shared with clones, but the policy is not.
+func (als *ArabicLightStemmer) SetRootPolicy(policy roots.RootPolicy) {
+	als.setRootsManager(als.rootsManager.WithPolicy(policy))
+}
+
+// GetRootPolicy returns the current policy for candidate roots.
+// By default roots have 3 or 4 letters, none of them ALEF.
+func (als *ArabicLightStemmer) GetRootPolicy() roots.RootPolicy {
+	return als.rootsManager.Policy()
+}
+
+// SetRestoreHamza enables or disables hamza seat restoration in the returned stems and roots.
+// Roots are otherwise returned with bare hamza as in the roots dictionary, e.g. سءل instead of سأل.
+func (als *ArabicLightStemmer) SetRestoreHamza(restoreHamza bool) {
+	als.restoreHamza = restoreHamza
+}
+
+// GetRestoreHamza returns whether hamza seats are restored in the returned stems and roots.
+// It is disabled by default, which keeps roots in their dictionary form.
+func (als *ArabicLightStemmer) GetRestoreHamza() bool {
+	return als.restoreHamza
+}
+
+// SetSkipLoanwords enables or disables loanword detection during stemming.
+// When enabled, transliterated foreign words (كمبيوتر، إنستغرام) are returned lightly normalized instead of stemmed.
+func (als *ArabicLightStemmer) SetSkipLoanwords(skipLoanwords bool) {
+	als.skipLoanwords = skipLoanwords
+}
+
+// GetSkipLoanwords returns whether loanwords are excluded from stemming.
+// It is disabled by default, so every word goes through the stemming process.
+func (als *ArabicLightStemmer) GetSkipLoanwords() bool {
+	return als.skipLoanwords
+}
+
+// SetConvertArabizi enables or disables Arabizi conversion before stemming.
+// When enabled, words written in romanized Arabic ("7abibi", "3ala") are converted to Arabic script and then stemmed.
+func (als *ArabicLightStemmer) SetConvertArabizi(convertArabizi bool) {
+	als.convertArabizi = convertArabizi
+}
+
+// GetConvertArabizi returns whether Arabizi words are converted to Arabic script before stemming.
+// It is disabled by default, so Latin-script words are processed as they are.
+func (als *ArabicLightStemmer) GetConvertArabizi() bool {
+	return als.convertArabizi
+}
+
+// SetSpellingTolerant enables or disables spelling error tolerance.
+// When enabled, a word without a dictionary root is retried with common error corrections (ه↔ة, ى↔ي, dropped hamza)
+// and then with single-edit variants, which makes stemming more resilient on OCR and user-generated text.
+func (als *ArabicLightStemmer) SetSpellingTolerant(spellingTolerant bool) {
+	als.spellingTolerant = spellingTolerant
+}
+
+// GetSpellingTolerant returns whether spelling error tolerance is enabled.
+// It is disabled by default, as retrying with variants is considerably slower than a single pass.
+func (als *ArabicLightStemmer) GetSpellingTolerant() bool {
+	return als.spellingTolerant
+}
+
+// SetLuceneCompatible enables or disables the Elasticsearch parity mode.
+// When enabled, LightStem applies the normalization and affix removal of Lucene's ArabicNormalizationFilter and
+// ArabicStemFilter instead of its own segmentation, so that stems computed in Go match the terms stored in an index.
+func (als *ArabicLightStemmer) SetLuceneCompatible(luceneCompatible bool) {
+	als.luceneCompatible = luceneCompatible
+}
+
+// GetLuceneCompatible returns whether the Elasticsearch parity mode is enabled.
+// Root extraction is not affected by this mode, as Lucene doesn't extract roots.
+func (als *ArabicLightStemmer) GetLuceneCompatible() bool {
+	return als.luceneCompatible
+}
+
+// SetAlefWasla sets how ALEF WASLA (ٱ), common in classical and Quranic texts, is treated before stemming.
+// It is replaced with a plain ALEF by default; kept, it is handled like any other letter of the stem.
+func (als *ArabicLightStemmer) SetAlefWasla(treatment normalize.AlefTreatment) {
+	als.alefWasla = treatment
+}
+
+// GetAlefWasla returns how ALEF WASLA is treated before stemming.
+// The default is normalize.AlefToPlain.
+func (als *ArabicLightStemmer) GetAlefWasla() normalize.AlefTreatment {
+	return als.alefWasla
+}
+
+// SetTehMarbuta sets how TEH MARBUTA (ة) is written in stems and normalized search text.
+// It is stripped by default, e.g. مدرسة → مدرس; mapped to HEH or kept, a word-final TEH MARBUTA stays in the stem,
+// and so does the TEH MARBUTA written as TEH before a possessive pronoun, e.g. مدرستها → مدرسة. Roots never keep it.
+// It returns ErrInvalidOption if the policy is unknown.
+func (als *ArabicLightStemmer) SetTehMarbuta(policy normalize.TehMarbutaPolicy) error {
+	if policy < normalize.TehMarbutaStrip || policy > normalize.TehMarbutaKeep {
+		return fmt.Errorf("%w: teh marbuta policy %d", ErrInvalidOption, policy)
+	}
+	als.tehMarbuta = policy
+	return nil
+}
+
+// GetTehMarbuta returns how TEH MARBUTA is written in stems and normalized search text.
+// The default is normalize.TehMarbutaStrip.
+func (als *ArabicLightStemmer) GetTehMarbuta() normalize.TehMarbutaPolicy {
+	return als.tehMarbuta
+}
+
+// SetAlefMaksura sets the mapping between ALEF MAKSURA (ى) and YEH (ي) applied to roots and normalized search text.
+// ALEF MAKSURA is written as YEH by default, which conflates words such as على and علي.
+// It returns ErrInvalidOption if the policy is unknown.
+func (als *ArabicLightStemmer) SetAlefMaksura(policy normalize.AlefMaksuraPolicy) error {
+	if policy < normalize.AlefMaksuraToYeh || policy > normalize.YehToAlefMaksura {
+		return fmt.Errorf("%w: alef maksura policy %d", ErrInvalidOption, policy)
+	}
+	als.alefMaksura = policy
+	return nil
+}
+
+// GetAlefMaksura returns the mapping between ALEF MAKSURA and YEH applied to roots and normalized search text.
+// The default is normalize.AlefMaksuraToYeh.
+func (als *ArabicLightStemmer) GetAlefMaksura() normalize.AlefMaksuraPolicy {
+	return als.alefMaksura
+}
+
+// SetHamzaLevel sets the level to which hamzas are normalized in roots and verb stamps, and rebuilds the verb stamps.
+// Every hamza is written as a bare HAMZA by default, as in the roots dictionary; the roots list should follow
+// the same convention at other levels for roots to be found. It returns ErrInvalidOption if the level is unknown.
+func (als *ArabicLightStemmer) SetHamzaLevel(level normalize.HamzaLevel) error {
+	if level < normalize.HamzaNone || level > normalize.HamzaFull {
+		return fmt.Errorf("%w: hamza level %d", ErrInvalidOption, level)
+	}
+	if level == als.hamzaLevel {
+		return nil
+	}
+	als.hamzaLevel = level
+	als.verbNormalizer = stamp.NewVerbNormalizerWithHamza(als.wordProcessor, level)
+	als.verbListManager = als.verbListManager.WithNormalizer(als.verbNormalizer)
+	return nil
+}
+
+// GetHamzaLevel returns the level to which hamzas are normalized in roots and verb stamps.
+// The default is normalize.HamzaFull.
+func (als *ArabicLightStemmer) GetHamzaLevel() normalize.HamzaLevel {
+	return als.hamzaLevel
+}
+
+// NormalizeSearchText normalizes the text as normalize.SearchText does, but applies the teh marbuta
+// and alef maksura policies of the stemmer, so that normalized stems follow the same conventions as the stemmer.
+func (als *ArabicLightStemmer) NormalizeSearchText(text string) string {
+	return normalize.Text(text, als.SearchOptions())
+}
+
+// SearchOptions returns the normalization options of NormalizeSearchText: those of normalize.SearchOptions
+// with the teh marbuta and alef maksura policies of the stemmer.
+func (als *ArabicLightStemmer) SearchOptions() normalize.Options {
+	options := normalize.SearchOptions()
+	options.TehMarbuta = als.tehMarbuta
+	options.AlefMaksura = als.alefMaksura
+	return options
+}
+
+// SetDaggerAlef sets how the superscript, or dagger, alef (ٰ) is treated before stemming.
+// It is removed by default, following the modern spelling of words such as هذا and رحمن.
+func (als *ArabicLightStemmer) SetDaggerAlef(treatment normalize.AlefTreatment) {
+	als.daggerAlef = treatment
+}
+
+// GetDaggerAlef returns how the superscript alef is treated before stemming.
+// The default is normalize.AlefStrip.
+func (als *ArabicLightStemmer) GetDaggerAlef() normalize.AlefTreatment {
+	return als.daggerAlef
+}
+
+// SetSegmentationStrategy sets the strategy used to choose among the valid segmentations of a word.
+// Different downstream tasks prefer different biases, e.g. StrategyLongestStem for high-precision search.
+// It returns ErrInvalidOption if the strategy is unknown.
+func (als *ArabicLightStemmer) SetSegmentationStrategy(strategy SegmentationStrategy) error {
+	if strategy < StrategyMaxPrefixMinSuffix || strategy > StrategyWeighted {
+		return fmt.Errorf("%w: segmentation strategy %d", ErrInvalidOption, strategy)
+	}
+	als.segmentationStrategy = strategy
+	return nil
+}
+
+// GetSegmentationStrategy returns the strategy used to choose among the valid segmentations of a word.
+// The default is StrategyMaxPrefixMinSuffix.
+func (als *ArabicLightStemmer) GetSegmentationStrategy() SegmentationStrategy {
+	return als.segmentationStrategy
+}
+
+// SetLanguageModel sets the character language model breaking ties between candidate stems and roots,
+// e.g. segmentations of equal length with StrategyLongestStem, or roots found equally often. Nil disables it.
+func (als *ArabicLightStemmer) SetLanguageModel(model ngram.Model) {
+	als.languageModel = model
+}
+
+// GetLanguageModel returns the character language model breaking ties between candidate stems and roots.
+// The default is nil, in which case ties are broken by order.
+func (als *ArabicLightStemmer) GetLanguageModel() ngram.Model {
+	return als.languageModel
+}
+
+// SetArabiziMapping sets the mapping of Latin letters, digraphs and digits to Arabic letters used for Arabizi conversion.
+// Longer keys take precedence, so digraphs such as "kh" and "sh" are matched before single letters.
+func (als *ArabicLightStemmer) SetArabiziMapping(mapping map[string]string) {
+	als.arabiziConverter = arabizi.NewArabiziConverter(mapping)
+}
+
+// SetPatternList sets the list of morphological templates used for pattern detection.
+// Templates use FEH, AIN and LAM as placeholders for the root letters, e.g. "مفعول".
+func (als *ArabicLightStemmer) SetPatternList(newPatternList []string) {
+	als.patternMatcher = pattern.NewPatternMatcher(newPatternList)
+}
+
+// GetPatternList returns the current list of morphological templates used for pattern detection.
+// The stemmer matches stems against these templates to identify their wazn and root letters.
+func (als *ArabicLightStemmer) GetPatternList() []string {
+	return als.patternMatcher.Templates()
+}
+
+// newAffixSet creates an affixSet from the lists of prefixes and suffixes, building their lookup trees.
+func newAffixSet(prefixList, suffixList []string) *affixSet {
+	return &affixSet{
+		prefixList:   prefixList,
+		suffixList:   suffixList,
+		prefixesTree: createPrefixTree(prefixList),
+		suffixesTree: createSuffixTree(suffixList),
+	}
+}
+
 // createPrefixTree creates a prefix tree from the list of prefixes.
 // It organizes prefixes into a tree structure to allow efficient prefix lookup during the stemming process.
-func (als *ArabicLightStemmer) createPrefixTree() map[string]interface{} {
-	prefixTree := make(map[string]interface{})
-	for _, prefix := range als.prefixList {
-		branch := prefixTree
-		for _, char := range prefix {
-			charStr := string(char)
-			if _, exists := branch[charStr]; !exists {
-				branch[charStr] = make(map[string]interface{})
-			}
-			branch = branch[charStr].(map[string]interface{})
-		}
-		if _, exists := branch["#"]; exists {
-			branch["#"].(map[string]interface{})[prefix] = "#"
-		} else {
-			branch["#"] = map[string]interface{}{prefix: "#"}
-		}
+func createPrefixTree(prefixList []string) *utils.Trie[rune] {
+	prefixTree := utils.NewTrie[rune]()
+	for _, prefix := range prefixList {
+		prefixTree.Insert([]rune(prefix))
 	}
-	als.prefixesTree = prefixTree
 	return prefixTree
 }
 
 // createSuffixTree creates a suffix tree from the list of suffixes.
 // It organizes suffixes into a tree structure in reverse order to allow efficient suffix lookup during the stemming process.
-func (als *ArabicLightStemmer) createSuffixTree() map[string]interface{} {
-	suffixTree := make(map[string]interface{})
-	for _, suffix := range als.suffixList {
-		branch := suffixTree
-		// Iterate over the suffix in reverse order
-		for i := len(suffix) - 1; i >= 0; {
-			r, size := utf8.DecodeLastRuneInString(suffix[:i+1])
-			charStr := string(r)
-			if _, exists := branch[charStr]; !exists {
-				branch[charStr] = make(map[string]interface{})
-			}
-			branch = branch[charStr].(map[string]interface{})
-			i -= size
-		}
-		if _, exists := branch["#"]; exists {
-			branch["#"].(map[string]interface{})[suffix] = "#"
-		} else {
-			branch["#"] = map[string]interface{}{suffix: "#"}
+func createSuffixTree(suffixList []string) *utils.Trie[rune] {
+	suffixTree := utils.NewTrie[rune]()
+	for _, suffix := range suffixList {
+		runeSuffix := []rune(suffix)
+		// Insert the suffix in reverse order
+		reversed := make([]rune, len(runeSuffix))
+		for i, char := range runeSuffix {
+			reversed[len(runeSuffix)-1-i] = char
 		}
+		suffixTree.Insert(reversed)
 	}
 	return suffixTree
 }
 
 // MostCommon returns the most common string from a list, prioritizing 3-letter roots.
 // This method is used to select the most frequent root or stem when multiple options are available.
+// Ties between equally frequent strings are broken by choosing the first in lexicographic order.
 func (als *ArabicLightStemmer) mostCommon(lst []string) string {
 	// Filter for three-letter roots
 	var triRoots []string
 	for _, item := range lst {
-		if len(item) == 3 {
+		if utf8.RuneCountInString(item) == 3 {
 			triRoots = append(triRoots, item)
 		}
 	}
@@ -280,6 +701,8 @@ func (als *ArabicLightStemmer) mostCommon(lst []string) string {
 	// If there are three-letter roots, use them instead of the full list
 	if len(triRoots) > 0 {
 		lst = triRoots
+	} else {
+		lst = append([]string{}, lst...)
 	}
 
 	// Create a map to count occurrences of each string
@@ -291,35 +714,199 @@ func (als *ArabicLightStemmer) mostCommon(lst []string) string {
 	// Sort the list to ensure consistent order
 	sort.Strings(lst)
 
-	// Find the most common element
-	var mostCommon string
+	// Find the most common elements, ties being broken by the language model
+	var mostCommon []string
 	maxCount := 0
 	for _, item := range lst {
-		if counts[item] > maxCount {
-			mostCommon = item
+		switch {
+		case counts[item] > maxCount:
+			mostCommon = []string{item}
 			maxCount = counts[item]
+		case counts[item] == maxCount && item != mostCommon[len(mostCommon)-1]:
+			mostCommon = append(mostCommon, item)
 		}
 	}
+	if len(mostCommon) == 0 {
+		return ""
+	}
 
-	return mostCommon
+	return mostCommon[als.mostPlausible(mostCommon)]
 }
 
-// IsRootLengthValid checks if the length of a root is valid, ensuring it is between 2 and 4 characters.
+// isRootLengthValid checks if a root is valid under the root policy, by default 3 or 4 letters without ALEF.
 // This validation is important to filter out roots that are too short or too long.
 func (als *ArabicLightStemmer) isRootLengthValid(root string) bool {
-	length := len(root)
-	return length >= 2 && length <= 4
+	return als.rootsManager.Policy().Valid(root)
 }
 
 // LightStem performs a light stemming operation on the given Arabic word and returns the stem.
 // This method simplifies the word by removing affixes and reducing it to its core stem.
+// With SetFallbackOriginal, the word is returned unchanged when the stem isn't confident enough.
 func (als *ArabicLightStemmer) LightStem(word string) string {
+	stem := als.lightStem(word)
+	if als.fallbackOriginal > 0 && als.stemConfidence(word, stem) < als.fallbackOriginal {
+		return word
+	}
+	return stem
+}
+
+// lightStem returns the light stem of the word, without falling back to the word itself.
+func (als *ArabicLightStemmer) lightStem(word string) string {
 	if word == "" {
 		return ""
 	}
+	if als.hashtagAware {
+		if stem, ok := als.stemHashtag(word); ok {
+			return stem
+		}
+	}
+	if key, ok := als.ExpressionKey(word); ok {
+		return key
+	}
+	if als.luceneCompatible {
+		return lucene.Stem(lucene.Normalize(word))
+	}
+	if stem, ok := als.engineStem(word); ok {
+		return stem
+	}
+	word = als.prepareWord(word)
+	if protected, ok := als.protectedWord(word); ok {
+		return protected
+	}
+	if stem, _, ok := als.shortWord(word); ok {
+		return stem
+	}
+	if normalized, ok := als.skipLoanword(word); ok {
+		return normalized
+	}
+	if stem, _, ok := als.comparativeStem(word); ok {
+		return stem
+	}
+	if stem, _, ok := als.diminutiveStem(word); ok {
+		return stem
+	}
+	if als.spellingTolerant {
+		word = als.correctSpelling(word)
+	}
+	if als.stripNisba {
+		if n, ok := als.analyzeNisba(als.wordProcessor.StripTashkeel(word)); ok {
+			word = n.Base
+		}
+	}
+	word, tehMarbuta := als.detachTehMarbuta(word)
 	_, unvocalized, stemLeft, stemRight := als.transform2Stars(word)
 	segmentList, unvocalized, left, right := als.segment(word)
-	return als.getStem(word, unvocalized, left, right, stemLeft, stemRight, -1, -1, segmentList)
+	stem := als.getStem(word, unvocalized, left, right, stemLeft, stemRight, -1, -1, segmentList)
+	if tehMarbuta == "" {
+		tehMarbuta = als.pronounTehMarbuta(unvocalized, stem)
+	}
+	stem += tehMarbuta
+	if als.restoreHamza {
+		stem = hamza.RestoreStem(stem)
+	}
+	return stem
+}
+
+// Tokenize splits the given text into word tokens that can be passed to LightStem or Root.
+// Tashkeel is kept within tokens, while punctuation and whitespace separate them.
+// In hashtag mode, hashtags are kept as single tokens, and so are the expressions set by SetExpressions.
+func (als *ArabicLightStemmer) Tokenize(text string) []string {
+	if als.hashtagAware {
+		return als.mergeExpressions(als.tokenizeHashtags(text))
+	}
+	return als.mergeExpressions(als.tokenizer.Tokenize(text))
+}
+
+// IsStopword checks if the given word, with or without tashkeel, is in the stopwords list.
+func (als *ArabicLightStemmer) IsStopword(word string) bool {
+	return als.stopWordManager.IsStopword(word) || als.stopWordManager.IsStopword(als.wordProcessor.StripTashkeel(word))
+}
+
+// Root extracts the root of the given Arabic word.
+// Each segmentation of the word yields a candidate root, weak and geminated roots are reconstructed unless a stem
+// reads as a dictionary root as it is, and the most common candidate found in the roots dictionary is returned.
+func (als *ArabicLightStemmer) Root(word string) string {
+	if word == "" {
+		return ""
+	}
+	if key, ok := als.ExpressionKey(word); ok {
+		return key
+	}
+	word = als.prepareWord(word)
+	if protected, ok := als.protectedWord(word); ok {
+		return protected
+	}
+	if _, root, ok := als.shortWord(word); ok {
+		return root
+	}
+	if normalized, ok := als.skipLoanword(word); ok {
+		return normalized
+	}
+	if _, root, ok := als.comparativeStem(word); ok {
+		return root
+	}
+	if _, root, ok := als.diminutiveStem(word); ok {
+		return root
+	}
+	if als.spellingTolerant {
+		word = als.correctSpelling(word)
+	}
+	root := als.findRoot(word)
+	if als.restoreHamza {
+		root = hamza.RestoreRoot(root)
+	}
+	return root
+}
+
+// findRoot extracts the root of a prepared word. If the gemination rules expand the shadda, the root of the expanded
+// word is preferred when it is a dictionary root ending in a doubled letter, e.g. مدد for مدّ, and either the shadda
+// is written on that letter or the root of the word as it is isn't in the dictionary. The shadda of the derived forms
+// is thus left out, e.g. علم for معلّم rather than عللم.
+func (als *ArabicLightStemmer) findRoot(word string) string {
+	root := als.segmentRoot(word)
+	if !als.geminationRules.ExpandShadda {
+		return root
+	}
+	doubled := geminate.ShaddaLetters(word)
+	if doubled == "" {
+		return root
+	}
+	expanded := als.segmentRoot(geminate.ExpandShadda(word))
+	letters := []rune(expanded)
+	if len(letters) < 3 || letters[len(letters)-1] != letters[len(letters)-2] || !als.rootsManager.IsRoot(expanded) {
+		return root
+	}
+	if strings.ContainsRune(doubled, letters[len(letters)-1]) || !als.rootsManager.IsRoot(root) {
+		return expanded
+	}
+	return root
+}
+
+// segmentRoot extracts the root of a prepared word from its segmentations.
+func (als *ArabicLightStemmer) segmentRoot(word string) string {
+	_, unvocalized, stemLeft, stemRight := als.transform2Stars(word)
+	segmentList, unvocalized, _, _ := als.segment(word)
+	return als.chooseRoot(word, unvocalized, "", stemLeft, stemRight, -1, -1, segmentList)
+}
+
+// prepareWord brings the input word into the form expected by the stemming process.
+// Arabizi words are converted to Arabic script when enabled, and LAM ALEF ligatures are expanded
+// so that a ligature-joined definite article is recognized as a prefix.
+func (als *ArabicLightStemmer) prepareWord(word string) string {
+	defer als.trace(StageNormalize)()
+	if als.convertArabizi && als.arabiziConverter.IsArabizi(word) {
+		word = als.arabiziConverter.Convert(word)
+	}
+	return als.normalizeLetters(word)
+}
+
+// normalizeLetters removes invisible characters and elongating kashidas, applies the alef treatments
+// and expands LAM ALEF ligatures. Each character is normalized independently of the others, which Align relies on.
+func (als *ArabicLightStemmer) normalizeLetters(word string) string {
+	options := normalize.LetterOptions()
+	options.AlefWasla = als.alefWasla
+	options.DaggerAlef = als.daggerAlef
+	return normalize.Text(word, options)
 }
 
 // Transform2Stars transforms all non-affixation letters in a word into a star (joker character, default '*').
@@ -330,9 +917,7 @@ func (als *ArabicLightStemmer) transform2Stars(word string) (string, string, int
 	word = strings.ReplaceAll(word, "آ", "أا")
 
 	// Replace all non-prefix and non-suffix letters with joker
-	nonAffixPattern := fmt.Sprintf("[^%s%s]", als.prefixLetters, als.suffixLetters)
-	re := regexp.MustCompile(nonAffixPattern)
-	word = re.ReplaceAllString(word, als.joker)
+	word = chars.Mask(word, als.letterClasses.affix, als.joker)
 
 	// Convert word to rune slice for proper character indexing
 	runeWord := []rune(word)
@@ -359,12 +944,12 @@ func (als *ArabicLightStemmer) transform2Stars(word string) (string, string, int
 		stem := string([]rune(word)[left:right])
 		suffix := string(runeWord[right:])
 
-		prefix = regexp.MustCompile(fmt.Sprintf("[^%s]", als.prefixLetters)).ReplaceAllString(prefix, als.joker)
+		prefix = chars.Mask(prefix, als.letterClasses.prefix, als.joker)
 
 		if als.infixLetters != "" {
-			stem = regexp.MustCompile(fmt.Sprintf("[^%s]", als.infixLetters)).ReplaceAllString(stem, als.joker)
+			stem = chars.Mask(stem, als.letterClasses.infix, als.joker)
 		}
-		suffix = regexp.MustCompile(fmt.Sprintf("[^%s]", als.suffixLetters)).ReplaceAllString(suffix, als.joker)
+		suffix = chars.Mask(suffix, als.letterClasses.suffix, als.joker)
 		word = prefix + stem + suffix
 	}
 
@@ -385,8 +970,9 @@ func (als *ArabicLightStemmer) transform2Stars(word string) (string, string, int
 		left = min(als.maxPrefixLength, len(runeWord)-2)
 	}
 	if left >= 0 {
+		affixes := als.affixes.Load()
 		prefix := string(runeWord[:left])
-		for prefix != "" && !utils.Contains(als.prefixList, prefix) {
+		for prefix != "" && !utils.Contains(affixes.prefixList, prefix) {
 			prefix = string([]rune(prefix)[:len([]rune(prefix))-1])
 		}
 		if right < 0 {
@@ -394,7 +980,7 @@ func (als *ArabicLightStemmer) transform2Stars(word string) (string, string, int
 		}
 		suffix := string(runeWord[right:])
 
-		for suffix != "" && !utils.Contains(als.suffixList, suffix) {
+		for suffix != "" && !utils.Contains(affixes.suffixList, suffix) {
 			suffix = string([]rune(suffix)[1:])
 		}
 		left = len([]rune(prefix))
@@ -403,7 +989,7 @@ func (als *ArabicLightStemmer) transform2Stars(word string) (string, string, int
 		// Get the original word segment and make all letters jokers except infixes
 		stem := string([]rune(word)[left:right])
 		if als.infixLetters != "" {
-			stem = regexp.MustCompile(fmt.Sprintf("[^%s]", als.infixLetters)).ReplaceAllString(stem, als.joker)
+			stem = chars.Mask(stem, als.letterClasses.infix, als.joker)
 		}
 		word = prefix + stem + suffix
 	}
@@ -419,8 +1005,14 @@ func (als *ArabicLightStemmer) transform2Stars(word string) (string, string, int
 // Segment segments the given word by identifying prefix and suffix positions.
 // It returns a map of segment indices, the unvocalized word, and the left and right positions of the stem.
 func (als *ArabicLightStemmer) segment(word string) (map[int][][2]int, string, int, int) {
+	defer als.trace(StageSegment)()
 	unvocalized := als.wordProcessor.StripTashkeel(word)
-	word = strings.ReplaceAll(word, constant.ALEF_MADDA, constant.HAMZA+constant.ALEF)
+	// Look affixes up on the unvocalized word, so that diacritics such as the shadda on a sun letter
+	// following the definite article (الشَّمس) don't block prefix recognition
+	word = strings.ReplaceAll(unvocalized, constant.ALEF_MADDA, constant.HAMZA+constant.ALEF)
+	if als.hooks.BeforeSegment != nil {
+		als.hooks.BeforeSegment(unvocalized)
+	}
 
 	var left, right int
 	// Get all left positions of prefixes
@@ -470,6 +1062,10 @@ func (als *ArabicLightStemmer) segment(word string) (map[int][][2]int, string, i
 		}
 	}
 
+	if als.hooks.AfterSegment != nil {
+		als.afterSegment(unvocalized, segmentList)
+	}
+
 	// Filter segments according to valid affixes list
 	left, right = als.getLeftRight(segmentList)
 
@@ -518,6 +1114,7 @@ func (als *ArabicLightStemmer) getStem(word, unvocalized string, left, right, st
 // ChooseStem selects the most appropriate stem from the word by evaluating possible segments.
 // It checks for stopwords, validates affixes, and returns the best possible stem.
 func (als *ArabicLightStemmer) chooseStem(word, unvocalized string, left, right, stemLeft, stemRight int, segmentList map[int][][2]int) string {
+	defer als.trace(StageVerify)()
 	// Check if the word is a stop word
 	if als.stopWordManager.IsStopword(word) {
 		return als.stopWordManager.StopStem(word)
@@ -547,8 +1144,8 @@ func (als *ArabicLightStemmer) chooseStem(word, unvocalized string, left, right,
 		left = 0
 		right = len(runeWord)
 	} else {
-		// Otherwise, choose the leftmost and rightmost valid segment
-		left, right = als.getLeftRight(validSegList)
+		// Otherwise, choose among the valid segments according to the segmentation strategy
+		left, right = als.selectSegment(word, unvocalized, stemLeft, stemRight, validSegList, segmentList)
 	}
 
 	// Ensure left and right are within bounds
@@ -569,19 +1166,18 @@ func (als *ArabicLightStemmer) verifyAffix(word, unvocalized string, left, right
 	prefix := als.getPrefix(unvocalized, left, prefixIndex)
 	suffix := als.getSuffix(unvocalized, right, suffixIndex)
 
-	affix := prefix + "-" + suffix
 	stem := als.getStem(word, unvocalized, left, right, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
 
-	if utils.AffixInList(affix, constant.VERB_AFFIX_LIST) && als.validStem(stem, "verb", prefix) {
-		if utils.AffixInList(affix, constant.NOUN_AFFIX_LIST) && als.validStem(stem, "noun", prefix) {
-			return true // Valid as both a verb and a noun
+	if als.requireDictionaryRoot {
+		root := als.getRoot(word, unvocalized, "", left, right, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
+		if !als.rootsManager.IsRoot(root) {
+			return als.rejectAffix(prefix, stem, suffix, RejectedRoot)
 		}
-		return true // Valid as a verb
 	}
-	if utils.AffixInList(affix, constant.NOUN_AFFIX_LIST) && als.validStem(stem, "noun", prefix) {
-		return true // Valid as a noun
-	}
-	return false // Not a valid verb or noun
+
+	// A constrained stemmer only accepts the affixes of the requested part of speech,
+	// others those valid as a verb or as a noun
+	return als.acceptedAffix(prefix, suffix, stem)
 }
 
 // GetPrefix extracts and returns the prefix of the word based on the given left and prefix indices.
@@ -659,8 +1255,8 @@ func (als *ArabicLightStemmer) validStem(stem string, tag string, prefix string)
 		if strings.HasPrefix(stem, constant.ALEF) && len(runePrefix) > 0 && strings.ContainsAny(string(runePrefix[len(runePrefix)-1]), constant.YEH+constant.NOON+constant.TEH+constant.ALEF_HAMZA_ABOVE+constant.ALEF) {
 			return false
 		}
-		// Lookup for verb stamp
-		if !als.verbListManager.IsVerbStamp(stem) {
+		// Lookup for verb stamp, including derived forms of quadriliteral verbs
+		if !als.verbListManager.IsVerbStamp(stem) && !als.verbListManager.IsQuadriliteralStamp(stem) {
 			return false
 		}
 
@@ -676,14 +1272,13 @@ func (als *ArabicLightStemmer) validStem(stem string, tag string, prefix string)
 
 // GetAffixList generates a list of possible affix combinations (prefix and suffix) for the word.
 // It uses segment indices to create tuples representing different combinations of prefixes and suffixes.
+// Tuples are listed by increasing prefix end, then increasing suffix start, so the order never depends on map iteration.
 func (als *ArabicLightStemmer) getAffixList(word, unvocalized, root string, stemLeft, stemRight, prefixIndex, suffixIndex int, segmentList map[int][][2]int) []map[string]string {
 	affixList := []map[string]string{}
-	for leftIndex, segmentPairs := range segmentList {
-		for _, pair := range segmentPairs {
-			rightIndex := pair[1]
-			affixTuple := als.getAffixTuple(word, unvocalized, root, leftIndex, rightIndex, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
-			affixList = append(affixList, affixTuple)
-		}
+	for _, segment := range sortedSegments(segmentList) {
+		leftIndex, rightIndex := segment[0], segment[1]
+		affixTuple := als.getAffixTuple(word, unvocalized, root, leftIndex, rightIndex, stemLeft, stemRight, leftIndex, rightIndex, segmentList)
+		affixList = append(affixList, affixTuple)
 	}
 	return affixList
 }
@@ -695,7 +1290,7 @@ func (als *ArabicLightStemmer) getAffixTuple(word, unvocalized, root string, lef
 		"prefix":   als.getPrefix(unvocalized, left, prefixIndex),
 		"suffix":   als.getSuffix(unvocalized, right, suffixIndex),
 		"stem":     als.getStem(word, unvocalized, left, right, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList),
-		"starstem": als.getStarStem(word, left, right, prefixIndex, suffixIndex),
+		"starstem": als.getStarStem(unvocalized, left, right, prefixIndex, suffixIndex),
 		"root":     als.getRoot(word, unvocalized, root, left, right, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList),
 	}
 }
@@ -704,7 +1299,7 @@ func (als *ArabicLightStemmer) getAffixTuple(word, unvocalized, root string, lef
 // This function handles the logic for determining the base root of the word after removing affixes.
 func (als *ArabicLightStemmer) getRoot(word, unvocalized, root string, left, right, stemLeft, stemRight, prefixIndex, suffixIndex int, segmentList map[int][][2]int) string {
 	if prefixIndex >= 0 || suffixIndex >= 0 {
-		als.extractRoot(word, unvocalized, root, left, right, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
+		root = als.extractRoot(word, unvocalized, root, left, right, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
 	} else {
 		root = als.chooseRoot(word, unvocalized, root, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
 	}
@@ -716,18 +1311,21 @@ func (als *ArabicLightStemmer) getRoot(word, unvocalized, root string, left, rig
 func (als *ArabicLightStemmer) extractRoot(word, unvocalized, root string, left, right, stemLeft, stemRight, prefixIndex, suffixIndex int, segmentList map[int][][2]int) string {
 	stem := als.getStem(word, unvocalized, left, right, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
 
+	runeStem := []rune(stem)
+
 	// If the stem has 3 letters, it can be the root directly
-	if len(stem) == 3 {
-		root = als.ajustRoot(root, stem)
-		return root
+	if len(runeStem) == 3 {
+		return als.ajustRoot(als.normalizeRoot(stem), stem)
 	}
 
-	starStem := als.getStarStem(word, left, right, prefixIndex, suffixIndex)
+	starStem := als.getStarStem(unvocalized, left, right, prefixIndex, suffixIndex)
+	runeStarStem := []rune(starStem)
+	jokerRune := []rune(als.joker)[0]
 	root = ""
 
-	if len(starStem) == len(stem) {
-		for i, char := range stem {
-			if string(starStem[i]) == als.joker {
+	if len(runeStarStem) == len(runeStem) {
+		for i, char := range runeStem {
+			if runeStarStem[i] == jokerRune {
 				root += string(char)
 			}
 		}
@@ -739,7 +1337,7 @@ func (als *ArabicLightStemmer) extractRoot(word, unvocalized, root string, left,
 	root = als.normalizeRoot(root)
 
 	// If the root length is 2, adjust the root
-	if len(root) == 2 {
+	if utf8.RuneCountInString(root) == 2 {
 		root = als.ajustRoot(root, starStem)
 	}
 
@@ -749,6 +1347,7 @@ func (als *ArabicLightStemmer) extractRoot(word, unvocalized, root string, left,
 // ChooseRoot selects the best root from the possible roots extracted from the word.
 // It applies length checks, dictionary validations, and frequency analysis to choose the most appropriate root.
 func (als *ArabicLightStemmer) chooseRoot(word, unvocalized, root string, stemLeft, stemRight, prefixIndex, suffixIndex int, segmentList map[int][][2]int) string {
+	defer als.trace(StageChooseRoot)()
 	if als.stopWordManager.IsStopword(word) {
 		return als.stopWordManager.StopRoot(word)
 	}
@@ -758,10 +1357,17 @@ func (als *ArabicLightStemmer) chooseRoot(word, unvocalized, root string, stemLe
 	}
 
 	affixList := als.getAffixList(word, unvocalized, root, stemLeft, stemRight, prefixIndex, suffixIndex, segmentList)
+	affixList = als.withoutArticle(affixList)
+	affixList = als.withoutSuffixWaw(affixList)
 	var roots []string
 	for _, d := range affixList {
 		roots = append(roots, d["root"])
 	}
+	// Weak roots are only reconstructed when no segmentation reads a dictionary root as it is, e.g. كتب in كتاب
+	if direct := als.directRoots(affixList); len(direct) > 0 {
+		roots = direct
+	}
+	roots = als.applyQuadriliteralPolicy(unvocalized, roots)
 
 	// Filter roots by valid length
 	var accepted []string
@@ -791,53 +1397,226 @@ func (als *ArabicLightStemmer) chooseRoot(word, unvocalized, root string, stemLe
 	return acceptedRoot
 }
 
-// AjustRoot modifies and refines the root based on specific patterns and linguistic rules.
-// It adjusts the root, especially in cases where the standard root extraction process needs fine-tuning.
-func (als *ArabicLightStemmer) ajustRoot(root, starstem string) string {
-	if starstem == "" {
-		return root
+// withoutArticle drops the segmentations keeping letters of the definite article in their stem when another
+// segmentation strips the article with a valid root, so that the article isn't read as root letters, e.g. لمل in المال.
+func (als *ArabicLightStemmer) withoutArticle(affixList []map[string]string) []map[string]string {
+	var article string
+	for _, d := range affixList {
+		if len(d["prefix"]) > len(article) && utils.Contains(constant.DEFINITE_ARTICLES, d["prefix"]) && als.isRootLengthValid(d["root"]) {
+			article = d["prefix"]
+		}
+	}
+	if article == "" {
+		return affixList
 	}
 
-	if len(starstem) == 3 {
-		starstem = strings.ReplaceAll(starstem, constant.ALEF, constant.WAW)
-		starstem = strings.ReplaceAll(starstem, constant.ALEF_MAKSURA, constant.YEH)
-		return starstem
+	var kept []map[string]string
+	for _, d := range affixList {
+		if len(d["prefix"]) < len(article) && strings.HasPrefix(article, d["prefix"]) {
+			continue
+		}
+		kept = append(kept, d)
 	}
+	return kept
+}
 
-	first := string(starstem[0])
-	last := string(starstem[len(starstem)-1])
+// withoutSuffixWaw drops the segmentations keeping letters of the plural suffix وا in their stem when another
+// segmentation of the same prefix strips it with a dictionary root of a stem of at least three letters, so that its
+// WAW isn't read as a weak root letter, e.g. قلو in قالوا.
+func (als *ArabicLightStemmer) withoutSuffixWaw(affixList []map[string]string) []map[string]string {
+	var kept []map[string]string
+	for _, d := range affixList {
+		dropped := false
+		for _, other := range affixList {
+			if other["suffix"] != constant.WAW+constant.ALEF || other["prefix"] != d["prefix"] ||
+				utf8.RuneCountInString(other["stem"]) < 3 || !als.rootsManager.IsRoot(other["root"]) {
+				continue
+			}
+			if len(d["suffix"]) < len(other["suffix"]) && d["stem"]+d["suffix"] == other["stem"]+other["suffix"] {
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			kept = append(kept, d)
+		}
+	}
+	return kept
+}
+
+// directRoots returns the triliteral dictionary roots read from the stems of the segmentations without reconstructing
+// weak letters. The consonant infixes TEH, TAH and DAL are read as root letters, only the long vowels are left out,
+// unless they stand in the infix slot of the derived forms VIII and X, e.g. the TEH of مختار or اجتماع. A stem still
+// holding the prefix of such a form gives no reading, so that the MEEM of مختار isn't read as a root letter.
+func (als *ArabicLightStemmer) directRoots(affixList []map[string]string) []string {
+	var direct []string
+	for _, d := range affixList {
+		stem := []rune(als.wordProcessor.StripTashkeel(d["stem"]))
+		starstem := []rune(d["starstem"])
+		if len(stem) != len(starstem) {
+			continue
+		}
+		var root strings.Builder
+		valid := true
+		for i, char := range stem {
+			switch string(starstem[i]) {
+			case als.joker, constant.TEH, constant.TAH, constant.DAL:
+				if lead := infixLead(d["prefix"]+string(stem[:i]), char, stem[i+1:]); lead != "" {
+					// The prefix, or the SEEN of the form X, is part of the stem
+					valid = i < utf8.RuneCountInString(lead) && !(strings.HasSuffix(lead, constant.SEEN) && i > 0)
+					continue
+				}
+				root.WriteRune(char)
+			}
+			if !valid {
+				break
+			}
+		}
+		candidate := als.normalizeRoot(root.String())
+		if valid && utf8.RuneCountInString(candidate) == 3 && als.isRootLengthValid(candidate) && als.rootsManager.IsRoot(candidate) {
+			direct = append(direct, candidate)
+		}
+	}
+	return direct
+}
 
+// infixLead returns the letters of a derived form VIII or X preceding an infix TEH, TAH or DAL, or an empty string
+// if the letter doesn't stand in its infix slot. The word may begin with a definite article or a one-letter proclitic.
+// The slot follows a prefix ALEF, MEEM, YEH, TEH or NOON and the first radical: TEH in اختار, مجتمع or استخدام,
+// TAH after an emphatic radical in اضطراب and DAL after DAL, THAL or ZAIN in ازدهار. An assimilated TEH may also
+// follow ALEF or MEEM right away, e.g. اتصال or متحد. At least two letters must follow, and not the WAW of مفعول.
+func infixLead(before string, infix rune, after []rune) string {
+	if len(after) < 2 || string(after[0]) == constant.WAW {
+		return ""
+	}
+	for _, article := range constant.DEFINITE_ARTICLES {
+		if trimmed, found := strings.CutPrefix(before, article); found {
+			before = trimmed
+			break
+		}
+	}
+	lead := []rune(before)
+	if len(lead) == 3 && strings.ContainsRune("وفبكلس", lead[0]) {
+		lead = lead[1:]
+	}
 	switch {
-	case first == constant.ALEF || first == constant.WAW:
-		root = constant.WAW + root
-	case first == constant.YEH:
-		root = constant.YEH + root
-	case first == als.joker && (last == constant.ALEF || last == constant.WAW):
-		root += constant.WAW
-	case first == als.joker && (last == constant.ALEF_MAKSURA || last == constant.YEH):
-		root += constant.WAW
-	case first == als.joker && last == als.joker:
-		if len(starstem) == 2 {
-			root += string(root[len(root)-1])
-		} else {
-			root = string(root[0]) + constant.WAW + string(root[1])
+	case len(lead) == 2 && strings.ContainsRune("امينت", lead[0]):
+		switch string(infix) {
+		case constant.TEH:
+			return string(lead)
+		case constant.TAH:
+			if strings.ContainsRune("صضطظ", lead[1]) {
+				return string(lead)
+			}
+		case constant.DAL:
+			if strings.ContainsRune("دذز", lead[1]) {
+				return string(lead)
+			}
 		}
+	case len(lead) == 1 && strings.ContainsRune("ام", lead[0]) && string(infix) == constant.TEH:
+		return string(lead)
 	}
+	return ""
+}
 
-	return root
+// applyQuadriliteralPolicy adjusts the candidate roots according to the quadriliteral policy.
+// Under QuadriliteralPrefer, roots inferred from quadriliteral templates are added, and if any four-letter candidate
+// is a dictionary root, only four-letter dictionary roots are kept. Under QuadriliteralDeny, four-letter roots are dropped.
+func (als *ArabicLightStemmer) applyQuadriliteralPolicy(unvocalized string, candidates []string) []string {
+	switch als.quadPolicy {
+	case roots.QuadriliteralPrefer:
+		for _, p := range als.patternMatcher.Match(unvocalized) {
+			if len(p.Slots) == 4 {
+				candidates = append(candidates, als.normalizeRoot(p.Root))
+			}
+		}
+		var quadriliteral []string
+		for _, root := range candidates {
+			if utf8.RuneCountInString(root) == 4 && als.rootsManager.IsRoot(root) {
+				quadriliteral = append(quadriliteral, root)
+			}
+		}
+		if len(quadriliteral) > 0 {
+			return quadriliteral
+		}
+	case roots.QuadriliteralDeny:
+		var triliteral []string
+		for _, root := range candidates {
+			if utf8.RuneCountInString(root) != 4 {
+				triliteral = append(triliteral, root)
+			}
+		}
+		return triliteral
+	}
+	return candidates
+}
+
+// AjustRoot modifies and refines the root based on specific patterns and linguistic rules.
+// Weak letters are reconstructed by the WeakRootResolver. A two-letter stem of root letters only may also be
+// geminated; the gemination rules decide whether the doubled reading is tried before or after the weak readings.
+func (als *ArabicLightStemmer) ajustRoot(root, starstem string) string {
+	if starstem == "" {
+		return root
+	}
+
+	adjusted := als.weakRootResolver.Reconstruct(root, starstem)
+	if !als.geminationRules.DoubleBiliteral || utf8.RuneCountInString(root) != 2 || starstem != strings.Repeat(als.joker, 2) {
+		return adjusted
+	}
+
+	geminated := geminate.Geminate(root)
+	if als.geminationRules.PreferGeminated && als.rootsManager.IsRoot(geminated) {
+		return geminated
+	}
+	if !als.rootsManager.IsRoot(adjusted) {
+		return geminated
+	}
+	return adjusted
 }
 
 // NormalizeRoot standardizes the root by applying a series of replacements and adjustments.
 // It ensures that the root conforms to expected linguistic norms in Arabic, such as handling specific characters.
 func (als *ArabicLightStemmer) normalizeRoot(word string) string {
-	// Replace ALEF_MADDA with HAMZA + ALEF
-	word = strings.ReplaceAll(word, constant.ALEF_MADDA, constant.HAMZA+constant.ALEF)
-	// Remove TEH_MARBUTA
-	word = strings.ReplaceAll(word, constant.TEH_MARBUTA, "")
-	// Replace ALEF_MAKSURA with YEH
-	word = strings.ReplaceAll(word, constant.ALEF_MAKSURA, constant.YEH)
-	// Normalize Hamza in the word
-	return utils.NormalizeHamza(word)
+	// Apply the hamza and alef maksura policies, by default writing roots as in the roots dictionary. The TEH MARBUTA
+	// is never a root letter, so it is stripped whatever the policy
+	options := normalize.RootOptions()
+	options.Hamza = als.hamzaLevel
+	options.AlefMaksura = als.alefMaksura
+	return normalize.Text(word, options)
+}
+
+// detachTehMarbuta removes the word-final TEH MARBUTA before segmentation when the policy keeps it in stems,
+// and returns it written according to the policy, to be appended to the stem.
+func (als *ArabicLightStemmer) detachTehMarbuta(word string) (string, string) {
+	if als.tehMarbuta == normalize.TehMarbutaStrip {
+		return word, ""
+	}
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	base, found := strings.CutSuffix(unvocalized, constant.TEH_MARBUTA)
+	if !found || utf8.RuneCountInString(base) < 2 {
+		return word, ""
+	}
+	return base, normalize.TehMarbuta(constant.TEH_MARBUTA, als.tehMarbuta)
+}
+
+// pronounTehMarbuta returns the TEH MARBUTA written according to the policy for a stem followed by a TEH and
+// a possessive pronoun when the policy keeps it in stems, e.g. مدرسة for مدرستها, or an empty string. The TEH is
+// left out when it is a root letter, as in بيتها, and after ALEF or WAW, as in سكوتها.
+func (als *ArabicLightStemmer) pronounTehMarbuta(unvocalized, stem string) string {
+	if als.tehMarbuta == normalize.TehMarbutaStrip || utf8.RuneCountInString(stem) < 3 ||
+		strings.HasSuffix(stem, constant.ALEF) || strings.HasSuffix(stem, constant.WAW) {
+		return ""
+	}
+	for _, pronoun := range possessivePronouns {
+		if !strings.HasSuffix(unvocalized, stem+constant.TEH+pronoun) {
+			continue
+		}
+		if strings.HasSuffix(als.findRoot(unvocalized), constant.TEH) {
+			return ""
+		}
+		return normalize.TehMarbuta(constant.TEH_MARBUTA, als.tehMarbuta)
+	}
+	return ""
 }
 
 // GetStarStem generates a "starred" version of the stem, where non-affix letters are replaced with a joker character.
@@ -863,13 +1642,12 @@ func (als *ArabicLightStemmer) getStarStem(word string, left, right int, prefixI
 	var newStarstem string
 	if als.infixLetters != "" {
 		// Convert all non-infix letters to the joker character
-		infixPattern := fmt.Sprintf("[^%s%s]", als.infixLetters, constant.TEH_MARBUTA)
-		newStarstem = regexp.MustCompile(infixPattern).ReplaceAllString(starword[tempLeft:tempRight], als.joker)
+		newStarstem = chars.Mask(sliceRunes(starword, tempLeft, tempRight), als.letterClasses.infixStem, als.joker)
 		// Handle specific infix cases
 		newStarstem = als.handleTehInfix(word, newStarstem, tempLeft, tempRight)
 	} else {
 		// If there are no infix letters, convert all characters to jokers
-		newStarstem = strings.Repeat(als.joker, len(starword[tempLeft:tempRight]))
+		newStarstem = strings.Repeat(als.joker, utf8.RuneCountInString(sliceRunes(starword, tempLeft, tempRight)))
 	}
 
 	return newStarstem
@@ -882,30 +1660,52 @@ func (als *ArabicLightStemmer) handleTehInfix(word, starword string, left, right
 
 	// Case of Teh Marbuta
 	keyStem := strings.ReplaceAll(newStarstem, constant.TEH_MARBUTA, "")
-	if len(keyStem) != 4 {
+	if utf8.RuneCountInString(keyStem) != 4 {
 		// Apply teh and variants only if the stem has 4 letters
 		newStarstem = regexp.MustCompile(fmt.Sprintf("[%s%s%s]", constant.TEH, constant.TAH, constant.DAL)).ReplaceAllString(newStarstem, als.joker)
 		return newStarstem
 	}
 
+	// The first two letters are kept as they are, infixes are only substituted after them
+	head := sliceRunes(newStarstem, 0, 2)
+	tail := sliceRunes(newStarstem, 2, utf8.RuneCountInString(newStarstem))
+	stem := sliceRunes(word, left, right)
+
 	// Substitute teh in infixes, the teh must be in the first or second place, all others are converted
-	newStarstem = newStarstem[:2] + strings.Replace(newStarstem[2:], constant.TEH, als.joker, -1)
+	tail = strings.ReplaceAll(tail, constant.TEH, als.joker)
 
 	// Tah طاء is an infix if preceded by DHAD only
-	if strings.HasPrefix(word[left:right], "ضط") {
-		newStarstem = newStarstem[:2] + strings.Replace(newStarstem[2:], constant.TAH, als.joker, -1)
+	if strings.HasPrefix(stem, "ضط") {
+		tail = strings.ReplaceAll(tail, constant.TAH, als.joker)
 	} else {
-		newStarstem = strings.ReplaceAll(newStarstem, constant.TAH, als.joker)
+		head = strings.ReplaceAll(head, constant.TAH, als.joker)
+		tail = strings.ReplaceAll(tail, constant.TAH, als.joker)
 	}
 
 	// DAL دال is an infix if preceded by ZAY only
-	if strings.HasPrefix(word[left:right], "زد") {
-		newStarstem = newStarstem[:2] + strings.Replace(newStarstem[2:], constant.DAL, als.joker, -1)
+	if strings.HasPrefix(stem, "زد") {
+		tail = strings.ReplaceAll(tail, constant.DAL, als.joker)
 	} else {
-		newStarstem = strings.ReplaceAll(newStarstem, constant.DAL, als.joker)
+		head = strings.ReplaceAll(head, constant.DAL, als.joker)
+		tail = strings.ReplaceAll(tail, constant.DAL, als.joker)
 	}
 
-	return newStarstem
+	return head + tail
+}
+
+// sliceRunes returns the part of the string between the given rune positions, clamped to the string bounds.
+func sliceRunes(text string, from, to int) string {
+	runeText := []rune(text)
+	if from < 0 {
+		from = 0
+	}
+	if to > len(runeText) {
+		to = len(runeText)
+	}
+	if from >= to {
+		return ""
+	}
+	return string(runeText[from:to])
 }
 
 // GetAffix returns a concatenated string of the prefix and suffix for the word, based on the provided indices.
@@ -946,26 +1746,25 @@ func (als *ArabicLightStemmer) getLeftRight(ls map[int][][2]int) (int, int) {
 // LookupPrefixes identifies and returns the positions of valid prefixes in the word by traversing the prefix tree.
 // This method is used to locate the starting points of potential prefixes that can be removed from the word.
 func (als *ArabicLightStemmer) lookupPrefixes(word string) []int {
-	branch := als.prefixesTree
+	branch := als.affixes.Load().prefixesTree
 	lefts := []int{0}
 	runeWord := []rune(word)
 	i := 0
 
-	for i < len(word) {
-		char := string(runeWord[i])
-		if _, ok := branch[char]; ok {
-			if _, hasHash := branch["#"]; hasHash {
-				lefts = append(lefts, i)
-			}
-			branch = branch[char].(map[string]interface{})
-		} else {
+	for i < len(runeWord) {
+		next, ok := branch.Child(runeWord[i])
+		if !ok {
 			break
 		}
+		if branch.Terminal() {
+			lefts = append(lefts, i)
+		}
+		branch = next
 		i++
 	}
 
-	if i < len(word) {
-		if _, hasHash := branch["#"]; hasHash {
+	if i < len(runeWord) {
+		if branch.Terminal() {
 			lefts = append(lefts, i)
 		}
 	}
@@ -976,27 +1775,24 @@ func (als *ArabicLightStemmer) lookupPrefixes(word string) []int {
 // LookupSuffixes identifies and returns the positions of valid suffixes in the word by traversing the suffix tree.
 // This method is used to locate the ending points of potential suffixes that can be removed from the word.
 func (als *ArabicLightStemmer) lookupSuffixes(word string) []int {
-	branch := als.suffixesTree
-	suffix := ""
+	branch := als.affixes.Load().suffixesTree
 	rights := []int{}
 	runeWord := []rune(word)
 	i := len(runeWord) - 1
 	for i >= 0 {
-		char := string(runeWord[i])
-		if _, ok := branch[char]; ok {
-			suffix = char + suffix
-			if _, hasHash := branch["#"]; hasHash {
-				rights = append(rights, i+1)
-			}
-			branch = branch[char].(map[string]interface{})
-		} else {
+		next, ok := branch.Child(runeWord[i])
+		if !ok {
 			break
 		}
+		if branch.Terminal() {
+			rights = append(rights, i+1)
+		}
+		branch = next
 		i--
 	}
 
 	if i >= 0 {
-		if _, hasHash := branch["#"]; hasHash {
+		if branch.Terminal() {
 			rights = append(rights, i+1)
 		}
 	}
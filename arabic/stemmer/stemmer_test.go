@@ -0,0 +1,198 @@
+package stemmer
+
+import (
+	"errors"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/geminate"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"testing"
+)
+
+func TestLightStemSunLetterArticle(t *testing.T) {
+	tests := []struct {
+		letter    string
+		word      string
+		vocalized string
+		ligature  string
+		stem      string
+	}{
+		{"ت", "التلال", "التَّلال", "التﻻل", "تلال"},
+		{"ث", "الثلاثة", "الثَّلاثة", "الثﻻثة", "ثلاث"},
+		{"د", "الدلالة", "الدَّلالة", "الدﻻلة", "دلال"},
+		{"ذ", "الذلال", "الذَّلال", "الذﻻل", "ذلال"},
+		{"ر", "الرحلات", "الرِّحلات", "الرحﻻت", "رحل"},
+		{"ز", "الزلازل", "الزَّلازل", "الزﻻزل", "زلازل"},
+		{"س", "السلام", "السَّلام", "السﻻم", "سلام"},
+		{"ش", "الشلال", "الشَّلال", "الشﻻل", "شلال"},
+		{"ص", "الصلاة", "الصَّلاة", "الصﻻة", "صلا"},
+		{"ض", "الضلال", "الضَّلال", "الضﻻل", "ضلال"},
+		{"ط", "الطلاب", "الطُّلاب", "الطﻻب", "طلاب"},
+		{"ظ", "الظلام", "الظَّلام", "الظﻻم", "ظلام"},
+		{"ل", "اللاعب", "اللّاعب", "الﻻعب", "لاعب"},
+		{"ن", "النبلاء", "النُّبلاء", "النبﻻء", "نبلاء"},
+	}
+	als := NewArabicLightStemmer()
+	for _, tt := range tests {
+		for _, word := range []string{tt.word, tt.vocalized, tt.ligature} {
+			if got := als.LightStem(word); got != tt.stem {
+				t.Errorf("sun letter %s: LightStem(%q) = %q, want %q", tt.letter, word, got, tt.stem)
+			}
+		}
+	}
+}
+
+func TestRootWeakReconstruction(t *testing.T) {
+	tests := []struct {
+		word string
+		root string
+	}{
+		{"كتاب", "كتب"},
+		{"الكتاب", "كتب"},
+		{"مكتوب", "كتب"},
+		{"قال", "قول"},
+		{"صام", "صوم"},
+		{"دعا", "دعو"},
+		{"وعد", "وعد"},
+		{"قالوا", "قول"},
+		{"مختار", "خير"},
+		{"متاح", "تيح"},
+		{"اجتماعات", "جمع"},
+	}
+	als := NewArabicLightStemmer()
+	for _, tt := range tests {
+		if got := als.Root(tt.word); got != tt.root {
+			t.Errorf("Root(%q) = %q, want %q", tt.word, got, tt.root)
+		}
+	}
+}
+
+func TestRootGeminationRules(t *testing.T) {
+	tests := []struct {
+		rules geminate.Rules
+		word  string
+		root  string
+	}{
+		{geminate.DefaultRules(), "مدّ", "مدد"},
+		{geminate.DefaultRules(), "ردّ", "ردد"},
+		{geminate.DefaultRules(), "الشَّمس", "شمس"},
+		{geminate.DefaultRules(), "الدّرس", "درس"},
+		{geminate.DefaultRules(), "النّور", "نور"},
+		{geminate.DefaultRules(), "مدرّس", "درس"},
+		{geminate.DefaultRules(), "معلّم", "علم"},
+		{geminate.DefaultRules(), "المعلّمون", "علم"},
+		{geminate.DefaultRules(), "مدرّسة", "درس"},
+		{geminate.DefaultRules(), "كسّر", "كسر"},
+		{geminate.Rules{ExpandShadda: true}, "ردّ", "ردد"},
+		{geminate.Rules{DoubleBiliteral: true, PreferGeminated: true}, "مد", "مدد"},
+		{geminate.Rules{DoubleBiliteral: true, PreferGeminated: true}, "الشَّمس", "شمس"},
+	}
+	for _, tt := range tests {
+		als := NewArabicLightStemmer()
+		als.SetGeminationRules(tt.rules)
+		if got := als.Root(tt.word); got != tt.root {
+			t.Errorf("rules %+v: Root(%q) = %q, want %q", tt.rules, tt.word, got, tt.root)
+		}
+	}
+}
+
+func TestRootDefiniteArticle(t *testing.T) {
+	tests := []struct {
+		word string
+		root string
+	}{
+		{"المال", "مول"},
+		{"اليوم", "يوم"},
+		{"البيت", "بيت"},
+		{"الباب", "بوب"},
+		{"الكتاب", "كتب"},
+		{"والكتاب", "كتب"},
+	}
+	als := NewArabicLightStemmer()
+	for _, tt := range tests {
+		if got := als.Root(tt.word); got != tt.root {
+			t.Errorf("Root(%q) = %q, want %q", tt.word, got, tt.root)
+		}
+	}
+}
+
+func TestRootSpellingTolerant(t *testing.T) {
+	tests := []struct {
+		word string
+		root string
+	}{
+		{"برلمان", "رلم"},
+		{"تلفزيون", "لفز"},
+		{"مدرسه", "درس"},
+		{"الجامعه", "جمع"},
+	}
+	als := NewArabicLightStemmer()
+	als.SetSpellingTolerant(true)
+	for _, tt := range tests {
+		if got := als.Root(tt.word); got != tt.root {
+			t.Errorf("Root(%q) = %q, want %q", tt.word, got, tt.root)
+		}
+	}
+}
+
+func TestTehMarbutaPolicy(t *testing.T) {
+	tests := []struct {
+		policy normalize.TehMarbutaPolicy
+		word   string
+		stem   string
+		root   string
+	}{
+		{normalize.TehMarbutaStrip, "مدرستها", "مدرس", "درس"},
+		{normalize.TehMarbutaToHeh, "مدرستها", "مدرسه", "درس"},
+		{normalize.TehMarbutaKeep, "مدرستها", "مدرسة", "درس"},
+		{normalize.TehMarbutaKeep, "غرفتي", "غرفة", "غرف"},
+		{normalize.TehMarbutaToHeh, "كرة", "كره", "كور"},
+		{normalize.TehMarbutaKeep, "كرة", "كرة", "كور"},
+	}
+	for _, tt := range tests {
+		als := NewArabicLightStemmer()
+		als.SetTehMarbuta(tt.policy)
+		if got := als.LightStem(tt.word); got != tt.stem {
+			t.Errorf("policy %d: LightStem(%q) = %q, want %q", tt.policy, tt.word, got, tt.stem)
+		}
+		if got := als.Root(tt.word); got != tt.root {
+			t.Errorf("policy %d: Root(%q) = %q, want %q", tt.policy, tt.word, got, tt.root)
+		}
+	}
+}
+
+func TestStopwordMaskPhrases(t *testing.T) {
+	tests := []struct {
+		text string
+		kept string
+	}{
+		{"من أجل السلام", "السلام"},
+		{"من اجل السلام", "السلام"},
+		{"بالاضافة الى ذلك", ""},
+		{"وعلى الرغم من الخلاف", "الخلاف"},
+		{"فمن أجل السلام", "السلام"},
+	}
+	als := NewArabicLightStemmer()
+	for _, tt := range tests {
+		if got := als.FilterStopwords(tt.text); got != tt.kept {
+			t.Errorf("FilterStopwords(%q) = %q, want %q", tt.text, got, tt.kept)
+		}
+	}
+}
+
+func TestSetInvalidOption(t *testing.T) {
+	als := NewArabicLightStemmer()
+	setters := map[string]error{
+		"engine":                als.SetEngine(EngineLight10Extended + 1),
+		"teh marbuta policy":    als.SetTehMarbuta(normalize.TehMarbutaKeep + 1),
+		"alef maksura policy":   als.SetAlefMaksura(-1),
+		"hamza level":           als.SetHamzaLevel(normalize.HamzaFull + 1),
+		"segmentation strategy": als.SetSegmentationStrategy(StrategyWeighted + 1),
+	}
+	for option, err := range setters {
+		if !errors.Is(err, ErrInvalidOption) {
+			t.Errorf("setting an unknown %s: got error %v, want ErrInvalidOption", option, err)
+		}
+	}
+	if err := als.Config().Validate(); err != nil {
+		t.Errorf("invalid options changed the configuration: %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stop_words"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"strings"
+)
+
+// RemoveStopwords returns the given tokens without the stopwords and the stop phrases, in their original order.
+// Tokens are compared with and without tashkeel, so vocalized stopwords are removed too.
+func (als *ArabicLightStemmer) RemoveStopwords(tokens []string) []string {
+	var kept []string
+	for i, stopword := range als.StopwordMask(tokens) {
+		if !stopword {
+			kept = append(kept, tokens[i])
+		}
+	}
+	return kept
+}
+
+// FilterStopwords tokenizes the given text and returns its non-stopword tokens joined by single spaces.
+// Punctuation is dropped along the way, as by Tokenize.
+func (als *ArabicLightStemmer) FilterStopwords(text string) string {
+	return strings.Join(als.RemoveStopwords(als.Tokenize(text)), " ")
+}
+
+// StopwordMask reports, for each token, whether it is a stopword or a word of a stop phrase such as من أجل.
+// At each position the longest stop phrase is matched, its words compared in their search form, so that
+// من اجل matches too. The first word of a phrase may carry the conjunction و or ف, e.g. وعلى الرغم من.
+func (als *ArabicLightStemmer) StopwordMask(tokens []string) []bool {
+	mask := make([]bool, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		if length := als.matchStopPhrase(tokens[i:]); length > 0 {
+			for j := i; j < i+length; j++ {
+				mask[j] = true
+			}
+			i += length - 1
+			continue
+		}
+		mask[i] = als.IsStopword(tokens[i])
+	}
+	return mask
+}
+
+// SetStopPhrases sets the multi-word phrases removed along with the stopwords, such as على الرغم من.
+// The phrases are tokenized like text and stripped of tashkeel. An empty list disables stop phrases.
+func (als *ArabicLightStemmer) SetStopPhrases(phrases []string) {
+	als.stopPhraseList = nil
+	als.stopPhrases = utils.NewTrie[string]()
+	for _, phrase := range phrases {
+		words := als.tokenizer.Tokenize(phrase)
+		if len(words) == 0 {
+			continue
+		}
+		keys := make([]string, len(words))
+		for i, word := range words {
+			words[i] = als.wordProcessor.StripTashkeel(word)
+			keys[i] = normalize.SearchText(word)
+		}
+		als.stopPhraseList = append(als.stopPhraseList, strings.Join(words, " "))
+		als.stopPhrases.Insert(keys)
+	}
+}
+
+// GetStopPhrases returns the stop phrases, tokenized and stripped of tashkeel, with their words separated by spaces.
+// By default they are constant.DEFAULT_STOP_PHRASES.
+func (als *ArabicLightStemmer) GetStopPhrases() []string {
+	return append([]string{}, als.stopPhraseList...)
+}
+
+// stopPhraseProclitics lists the conjunctions the first word of a stop phrase may carry, e.g. وعلى الرغم من.
+var stopPhraseProclitics = []string{"و", "ف"}
+
+// matchStopPhrase returns the number of tokens of the longest stop phrase the tokens start with, or 0 if none.
+func (als *ArabicLightStemmer) matchStopPhrase(tokens []string) int {
+	if len(tokens) == 0 {
+		return 0
+	}
+	first := normalize.SearchText(tokens[0])
+	longest := als.matchStopPhraseFrom(first, tokens[1:])
+	for _, proclitic := range stopPhraseProclitics {
+		if rest, ok := strings.CutPrefix(first, proclitic); ok && rest != "" {
+			longest = max(longest, als.matchStopPhraseFrom(rest, tokens[1:]))
+		}
+	}
+	return longest
+}
+
+// matchStopPhraseFrom returns the number of tokens of the longest stop phrase made of the first word, in search
+// form, followed by the tokens, or 0 if none.
+func (als *ArabicLightStemmer) matchStopPhraseFrom(first string, tokens []string) int {
+	node, ok := als.stopPhrases.Child(first)
+	if !ok {
+		return 0
+	}
+	longest := 0
+	if node.Terminal() {
+		longest = 1
+	}
+	for i, token := range tokens {
+		child, ok := node.Child(normalize.SearchText(token))
+		if !ok {
+			break
+		}
+		node = child
+		if node.Terminal() {
+			longest = i + 2
+		}
+	}
+	return longest
+}
+
+// LookupStopword returns the annotations (lemma, part of speech, vocalized form, variants) of the given stopword,
+// and false if the word isn't a stopword. A vocalized word is also looked up without its tashkeel.
+func (als *ArabicLightStemmer) LookupStopword(word string) (stop_words.Stopword, bool) {
+	if stopword, ok := als.stopWordManager.Lookup(word); ok {
+		return stopword, true
+	}
+	return als.stopWordManager.Lookup(als.wordProcessor.StripTashkeel(word))
+}
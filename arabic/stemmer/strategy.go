@@ -0,0 +1,114 @@
+package stemmer
+
+import (
+	"math"
+)
+
+// SegmentationStrategy controls which valid segmentation LightStem keeps when a word has several.
+type SegmentationStrategy int
+
+const (
+	// StrategyMaxPrefixMinSuffix combines the longest valid prefix with the longest valid suffix.
+	// This is the original Tashaphyne behavior and the default.
+	StrategyMaxPrefixMinSuffix SegmentationStrategy = iota
+	// StrategyLongestStem keeps the valid segmentation with the longest stem, removing as few letters as possible.
+	StrategyLongestStem
+	// StrategyShortestStem keeps the valid segmentation with the shortest stem, removing as many letters as possible.
+	StrategyShortestStem
+	// StrategyMostFrequentRoot only considers the valid segmentations yielding the root most of them agree on,
+	// preferring dictionary roots, and applies the max-prefix/min-suffix rule to those.
+	StrategyMostFrequentRoot
+	// StrategyWeighted keeps the valid segmentation whose prefix, suffix and stem length are the most likely
+	// according to the affix weights, rather than the one with the longest affixes.
+	StrategyWeighted
+)
+
+// selectSegment returns the left and right indices of the stem chosen by the segmentation strategy
+// among the valid segments. Ties are broken by the language model if any, then by the smallest left index,
+// then the smallest right index.
+func (als *ArabicLightStemmer) selectSegment(word, unvocalized string, stemLeft, stemRight int, validSegList, segmentList map[int][][2]int) (int, int) {
+	segments := sortedSegments(validSegList)
+	runeWord := []rune(unvocalized)
+
+	switch als.segmentationStrategy {
+	case StrategyLongestStem:
+		best := als.bestSegment(runeWord, segments, func(segment [2]int) float64 {
+			return float64(segment[1] - segment[0])
+		})
+		return best[0], best[1]
+	case StrategyShortestStem:
+		best := als.bestSegment(runeWord, segments, func(segment [2]int) float64 {
+			return float64(segment[0] - segment[1])
+		})
+		return best[0], best[1]
+	case StrategyMostFrequentRoot:
+		roots := make([]string, len(segments))
+		for i, segment := range segments {
+			roots[i] = als.getAffixTuple(word, unvocalized, "", segment[0], segment[1], stemLeft, stemRight, segment[0], segment[1], segmentList)["root"]
+		}
+		var dictionaryRoots []string
+		for _, root := range roots {
+			if als.rootsManager.IsRoot(root) {
+				dictionaryRoots = append(dictionaryRoots, root)
+			}
+		}
+		if len(dictionaryRoots) == 0 {
+			dictionaryRoots = roots
+		}
+		root := als.mostCommon(dictionaryRoots)
+		agreeing := make(map[int][][2]int)
+		for i, segment := range segments {
+			if roots[i] == root {
+				agreeing[segment[0]] = append(agreeing[segment[0]], segment)
+			}
+		}
+		return als.getLeftRight(agreeing)
+	case StrategyWeighted:
+		best := als.bestSegment(runeWord, segments, func(segment [2]int) float64 {
+			return als.affixWeights.Score(string(runeWord[:segment[0]]), string(runeWord[segment[1]:]), segment[1]-segment[0])
+		})
+		return best[0], best[1]
+	}
+	return als.getLeftRight(validSegList)
+}
+
+// bestSegment returns the segment of the word with the highest value. Segments tied on it are ranked
+// by the language model score of their stem when a model is set, and otherwise the first one is kept.
+func (als *ArabicLightStemmer) bestSegment(runeWord []rune, segments [][2]int, value func(segment [2]int) float64) [2]int {
+	var tied [][2]int
+	bestValue := math.Inf(-1)
+	for _, segment := range segments {
+		if segment[1] > len(runeWord) {
+			continue
+		}
+		switch v := value(segment); {
+		case v > bestValue:
+			tied, bestValue = [][2]int{segment}, v
+		case v == bestValue:
+			tied = append(tied, segment)
+		}
+	}
+	if len(tied) == 0 {
+		return segments[0]
+	}
+	stems := make([]string, len(tied))
+	for i, segment := range tied {
+		stems[i] = string(runeWord[segment[0]:segment[1]])
+	}
+	return tied[als.mostPlausible(stems)]
+}
+
+// mostPlausible returns the index of the candidate scored highest by the language model,
+// or 0 when no model is set. Ties keep the earliest candidate.
+func (als *ArabicLightStemmer) mostPlausible(candidates []string) int {
+	if als.languageModel == nil || len(candidates) < 2 {
+		return 0
+	}
+	best, bestScore := 0, als.languageModel.Score(candidates[0])
+	for i, candidate := range candidates[1:] {
+		if score := als.languageModel.Score(candidate); score > bestScore {
+			best, bestScore = i+1, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,121 @@
+package stemmer
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// StemResult is the light stem of a word received by StemStream.
+type StemResult struct {
+	// Index is the position of the word in the input stream, starting at 0.
+	Index int    `json:"index"`
+	Word  string `json:"word"`
+	Stem  string `json:"stem"`
+}
+
+// StreamOptions configures StemStreamWithOptions. The zero value stems in order with one worker per CPU.
+type StreamOptions struct {
+	// Workers is the number of goroutines stemming words concurrently. Zero or less uses runtime.GOMAXPROCS(0).
+	Workers int
+	// Unordered emits results as soon as they are ready instead of in input order, which avoids waiting
+	// for a slow word. Results can still be matched to their words through Index.
+	Unordered bool
+}
+
+// StemStream light stems the words received on the input channel and emits the results, in input order, on the
+// returned channel. The output channel is unbuffered and at most two words per worker are in flight, so a slow
+// consumer slows down the reading of the input. The output channel is closed once the input channel is closed and
+// all its words are stemmed, or as soon as the context is done.
+func (als *ArabicLightStemmer) StemStream(ctx context.Context, in <-chan string) <-chan StemResult {
+	return als.StemStreamWithOptions(ctx, in, StreamOptions{})
+}
+
+// StemStreamWithOptions is like StemStream, with control over the number of workers and the order of the results.
+func (als *ArabicLightStemmer) StemStreamWithOptions(ctx context.Context, in <-chan string, opts StreamOptions) <-chan StemResult {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	// Each word holds a slot from reading until its result is emitted, bounding the words buffered for reordering
+	slots := make(chan struct{}, 2*workers)
+	jobs := make(chan StemResult)
+	results := make(chan StemResult)
+	out := make(chan StemResult)
+
+	go func() {
+		defer close(jobs)
+		for index := 0; ; index++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case word, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- StemResult{Index: index, Word: word}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				job.Stem = als.LightStem(job.Word)
+				select {
+				case results <- job:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	go func() {
+		defer close(out)
+		emit := func(result StemResult) bool {
+			select {
+			case out <- result:
+				<-slots
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		pending := make(map[int]StemResult)
+		next := 0
+		for result := range results {
+			if opts.Unordered {
+				if !emit(result) {
+					return
+				}
+				continue
+			}
+			pending[result.Index] = result
+			for ready, ok := pending[next]; ok; ready, ok = pending[next] {
+				delete(pending, next)
+				if !emit(ready) {
+					return
+				}
+				next++
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,25 @@
+package stemmer
+
+import (
+	"sort"
+	"strings"
+)
+
+// NormalizeTitle returns a canonical key for a title, e.g. of a news article, so that titles differing only in
+// word order, stopwords, inflection or spelling variants share it. The words of the title that aren't stopwords
+// are light stemmed and normalized for search, then the distinct stems are sorted and joined with spaces.
+// A title made only of stopwords has an empty key.
+func (als *ArabicLightStemmer) NormalizeTitle(s string) string {
+	seen := make(map[string]bool)
+	var stems []string
+	for _, token := range als.RemoveStopwords(als.Tokenize(s)) {
+		stem := als.NormalizeSearchText(als.LightStem(token))
+		if stem == "" || seen[stem] {
+			continue
+		}
+		seen[stem] = true
+		stems = append(stems, stem)
+	}
+	sort.Strings(stems)
+	return strings.Join(stems, " ")
+}
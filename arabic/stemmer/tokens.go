@@ -0,0 +1,153 @@
+package stemmer
+
+import (
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/numeral"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/tokenizer"
+)
+
+// textTokenizer is the tokenizer of the stemmer, created by tokenizer.NewTokenizer, which also keeps compounds
+// together and labels tokens with their type.
+type textTokenizer interface {
+	tokenizer.Tokenizer
+	tokenizer.CompoundTokenizer
+	tokenizer.TypedTokenizer
+}
+
+// TokenPolicy controls what StemTokens does with the tokens of a type.
+type TokenPolicy int
+
+const (
+	// TokenStem stems the token: words with LightStem and hashtags component by component, e.g. #يوم_جمعة.
+	// Numbers written with words and dates are normalized, e.g. to 23 or 2023-05-15, and Hijri dates marked
+	// with هـ, e.g. 1445-09-03هـ. Tokens of the other types are kept as written.
+	TokenStem TokenPolicy = iota
+	// TokenKeep keeps the token as written, as its own stem.
+	TokenKeep
+	// TokenDrop leaves the token out of the results.
+	TokenDrop
+)
+
+// StemmedToken is a token of a text, labeled with its type, and its stem.
+type StemmedToken struct {
+	tokenizer.Token
+	Stem string
+}
+
+// DefaultTokenPolicies returns the token policies of a new stemmer: Arabic words and hashtags are stemmed,
+// numbers written with words and dates are normalized, punctuation is dropped, and the other tokens are kept as written.
+func DefaultTokenPolicies() map[tokenizer.TokenType]TokenPolicy {
+	return map[tokenizer.TokenType]TokenPolicy{
+		tokenizer.ArabicWord:  TokenStem,
+		tokenizer.LatinWord:   TokenKeep,
+		tokenizer.Number:      TokenKeep,
+		tokenizer.Punctuation: TokenDrop,
+		tokenizer.Emoji:       TokenKeep,
+		tokenizer.URL:         TokenKeep,
+		tokenizer.Mention:     TokenKeep,
+		tokenizer.Hashtag:     TokenStem,
+		tokenizer.NumberWords: TokenStem,
+		tokenizer.Date:        TokenStem,
+	}
+}
+
+// SetTokenPolicy sets what StemTokens does with the tokens of the given type.
+// It returns ErrInvalidOption if the token type or the policy is unknown.
+func (als *ArabicLightStemmer) SetTokenPolicy(tokenType tokenizer.TokenType, policy TokenPolicy) error {
+	if err := validTokenPolicy(tokenType, policy); err != nil {
+		return err
+	}
+	// The map is replaced rather than modified, as clones share it
+	policies := als.GetTokenPolicies()
+	policies[tokenType] = policy
+	als.tokenPolicies = policies
+	return nil
+}
+
+// GetTokenPolicy returns what StemTokens does with the tokens of the given type.
+// The defaults are given by DefaultTokenPolicies.
+func (als *ArabicLightStemmer) GetTokenPolicy(tokenType tokenizer.TokenType) TokenPolicy {
+	return als.tokenPolicies[tokenType]
+}
+
+// GetTokenPolicies returns a copy of the policies of every token type.
+func (als *ArabicLightStemmer) GetTokenPolicies() map[tokenizer.TokenType]TokenPolicy {
+	policies := make(map[tokenizer.TokenType]TokenPolicy, len(als.tokenPolicies))
+	for tokenType, policy := range als.tokenPolicies {
+		policies[tokenType] = policy
+	}
+	return policies
+}
+
+// StemTokens splits the text into typed tokens, see tokenizer.TokenizeTyped, and stems them according to
+// the policy of their type, in the order they appear. The tokens of a number written with words or of a date,
+// see numeral.Find, are merged into a single NumberWords or Date token, so that they aren't stemmed as words.
+func (als *ArabicLightStemmer) StemTokens(text string) []StemmedToken {
+	text = normalize.StripInvisible(text)
+	tokens := als.tokenizer.TokenizeTyped(text)
+	expressions := numeral.Find(tokens)
+	var stemmed []StemmedToken
+	for i := 0; i < len(tokens); i++ {
+		token, value := tokens[i], ""
+		if len(expressions) > 0 && expressions[0].Start == i {
+			token, value = expressionToken(text, tokens, expressions[0])
+			i = expressions[0].End - 1
+			expressions = expressions[1:]
+		}
+
+		stem := token.Text
+		switch als.tokenPolicies[token.Type] {
+		case TokenDrop:
+			continue
+		case TokenStem:
+			if value != "" {
+				stem = value
+			} else {
+				stem = als.stemToken(token)
+			}
+		}
+		stemmed = append(stemmed, StemmedToken{Token: token, Stem: stem})
+	}
+	return stemmed
+}
+
+// expressionToken merges the tokens of a number or date expression into a single token, returned with
+// the normalized value of the expression.
+func expressionToken(text string, tokens []tokenizer.Token, expression numeral.Expression) (tokenizer.Token, string) {
+	first, last := tokens[expression.Start], tokens[expression.End-1]
+	token := tokenizer.Token{Text: text[first.Offset : last.Offset+len(last.Text)], Type: tokenizer.NumberWords, Offset: first.Offset}
+	value := expression.Value
+	if expression.Kind == numeral.Date {
+		token.Type = tokenizer.Date
+		if expression.Hijri {
+			value += constant.HEH + constant.TATWEEL
+		}
+	}
+	return token, value
+}
+
+// stemToken returns the stem of a token whose type is stemmed.
+func (als *ArabicLightStemmer) stemToken(token tokenizer.Token) string {
+	switch token.Type {
+	case tokenizer.ArabicWord, tokenizer.LatinWord:
+		return als.LightStem(token.Text)
+	case tokenizer.Hashtag:
+		if stem, ok := als.stemHashtag(token.Text); ok {
+			return stem
+		}
+	}
+	return token.Text
+}
+
+// validTokenPolicy returns ErrInvalidOption if the token type or the policy is unknown.
+func validTokenPolicy(tokenType tokenizer.TokenType, policy TokenPolicy) error {
+	if tokenType < tokenizer.ArabicWord || tokenType > tokenizer.Date {
+		return fmt.Errorf("%w: token type %d", ErrInvalidOption, tokenType)
+	}
+	if policy < TokenStem || policy > TokenDrop {
+		return fmt.Errorf("%w: %s token policy %d", ErrInvalidOption, tokenType, policy)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package stemmer
+
+import (
+	"context"
+)
+
+// Stage is a stage of the stemming pipeline traced by a Tracer.
+type Stage string
+
+const (
+	// StageLightStem and StageRoot span a whole LightStemContext or RootContext call, parent of the other stages.
+	StageLightStem Stage = "light_stem"
+	StageRoot      Stage = "root"
+	// StageNormalize is the normalization of the letters of the word, and the Arabizi conversion if enabled.
+	StageNormalize Stage = "normalize"
+	// StageSegment is the lookup of the candidate segmentations of the word.
+	StageSegment Stage = "segment"
+	// StageVerify is the verification of the segmentations against the affix rules and the choice of the stem.
+	StageVerify Stage = "verify"
+	// StageChooseRoot is the extraction of the candidate roots and the choice of the root.
+	StageChooseRoot Stage = "choose_root"
+)
+
+// Tracer starts spans around the stages of stemming, attributing the latency of the stemmer in distributed traces.
+// Start returns the context of the new span and a function ending it. An OpenTelemetry tracer is adapted with:
+//
+//	type otelTracer struct{ tracer trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, stage stemmer.Stage) (context.Context, func()) {
+//		ctx, span := t.tracer.Start(ctx, "arabic."+string(stage))
+//		return ctx, func() { span.End() }
+//	}
+type Tracer interface {
+	Start(ctx context.Context, stage Stage) (context.Context, func())
+}
+
+// SetTracer enables tracing of the stages of stemming with the given tracer, or disables it if the tracer is nil,
+// which is the default. LightStem and Root trace their stages as root spans; use LightStemContext and
+// RootContext to attach them to the span of a request.
+func (als *ArabicLightStemmer) SetTracer(tracer Tracer) {
+	als.tracer = tracer
+}
+
+// GetTracer returns the tracer of the stages of stemming, or nil if tracing is disabled.
+func (als *ArabicLightStemmer) GetTracer() Tracer {
+	return als.tracer
+}
+
+// LightStemContext is LightStem, tracing its stages as children of the span of the context.
+func (als *ArabicLightStemmer) LightStemContext(ctx context.Context, word string) string {
+	if als.tracer == nil {
+		return als.LightStem(word)
+	}
+	traced, end := als.withTrace(ctx, StageLightStem)
+	defer end()
+	return traced.LightStem(word)
+}
+
+// RootContext is Root, tracing its stages as children of the span of the context.
+func (als *ArabicLightStemmer) RootContext(ctx context.Context, word string) string {
+	if als.tracer == nil {
+		return als.Root(word)
+	}
+	traced, end := als.withTrace(ctx, StageRoot)
+	defer end()
+	return traced.Root(word)
+}
+
+// withTrace starts a span for the call and returns a shallow copy of the stemmer tracing its stages under it.
+func (als *ArabicLightStemmer) withTrace(ctx context.Context, stage Stage) (*ArabicLightStemmer, func()) {
+	spanContext, end := als.tracer.Start(ctx, stage)
+	traced := *als
+	traced.traceContext = spanContext
+	return &traced, end
+}
+
+// trace starts a span for a stage and returns the function ending it, which does nothing if tracing is disabled.
+func (als *ArabicLightStemmer) trace(stage Stage) func() {
+	if als.tracer == nil {
+		return noTrace
+	}
+	ctx := als.traceContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, end := als.tracer.Start(ctx, stage)
+	return end
+}
+
+// noTrace ends the spans of a stemmer without a tracer.
+func noTrace() {}
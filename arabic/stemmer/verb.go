@@ -0,0 +1,41 @@
+package stemmer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/affix"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/verb"
+)
+
+// AnalyzeVerb returns the morphosyntactic readings of the given word as a conjugated verb.
+// Every segmentation whose prefix-suffix combination is allowed by the verb affix rules and whose stem is a valid verb stem
+// is mapped to person, gender, number and tense, e.g. يكتبون → 3rd person masculine plural imperfect.
+// When the word is vocalized, the voice of each reading is guessed from its vowels, e.g. كُتِبَ → passive perfect.
+// It returns nil if the word has no valid verb segmentation.
+func (als *ArabicLightStemmer) AnalyzeVerb(word string) []verb.Features {
+	unvocalized := als.wordProcessor.StripTashkeel(word)
+	if unvocalized == "" || als.stopWordManager.IsStopword(unvocalized) {
+		return nil
+	}
+	segmentList, _, _, _ := als.segment(unvocalized)
+	runeWord := []rune(unvocalized)
+
+	var features []verb.Features
+	for _, segment := range sortedSegments(segmentList) {
+		left, right := segment[0], segment[1]
+		if right > len(runeWord) {
+			continue
+		}
+		prefix := string(runeWord[:left])
+		stem := string(runeWord[left:right])
+		suffix := string(runeWord[right:])
+		if !als.validAffix(affix.TagVerb, prefix, suffix, stem) {
+			continue
+		}
+		features = append(features, verb.Analyze(prefix, stem, suffix)...)
+	}
+	if unvocalized != word {
+		for i := range features {
+			features[i].Voice = verb.DetectVoice(word, features[i])
+		}
+	}
+	return features
+}
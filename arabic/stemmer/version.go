@@ -0,0 +1,11 @@
+package stemmer
+
+// Version identifies the output behavior of the stemmer. It changes whenever a release may return a different stem
+// or root for the same input and configuration, including changes to the default dictionaries and affix lists.
+//
+// Within a version, outputs are deterministic: candidates are always examined in the same order, and ties between
+// equally good candidates are broken by fixed rules instead of map iteration order:
+//   - affix segmentations are ordered by increasing prefix end, then increasing suffix start;
+//   - among candidate roots, valid lengths, then dictionary roots, then triliteral roots are preferred;
+//   - among the remaining roots, the most frequent wins, and equally frequent roots are ordered lexicographically.
+const Version = "0.17.0"
@@ -1,41 +1,70 @@
 package stop_words
 
 import (
+	_ "embed"
 	"encoding/json"
-	"log"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/dictfile"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/utils"
+	"io"
+	"maps"
 	"os"
+	"sort"
+	"sync/atomic"
 )
 
 type StopwordManager interface {
 	IsStopword(word string) bool
 	StopStem(word string) string
 	StopRoot(word string) string
+	Lookup(word string) (Stopword, bool)
+	Reload(filename string) error
+	Export(w io.Writer) error
+}
+
+// StopwordMerger is implemented by stopword managers able to layer another stopwords document on top of their own,
+// such as those created by NewStopwordManager. It is kept apart from StopwordManager so that other implementations
+// needn't provide it.
+type StopwordMerger interface {
+	Merge(data []byte, replace bool) (StopwordManager, []string, error)
 }
 
 // stopwordManager manages stopwords.
 type stopwordManager struct {
-	stopwords map[string]map[string]string
+	set       atomic.Pointer[stopwordSet]
 	processor WordProcessor
 }
 
+// stopwordSet holds the stopwords with their variant index, replaced as a whole on reload.
+type stopwordSet struct {
+	stopwords    map[string]map[string]string
+	variantIndex map[string][]string
+}
+
+// defaultStopwords is the stopwords.json file shipped with the package, embedded so that the default stopwords
+// load whatever the working directory.
+//
+//go:embed stopwords.json
+var defaultStopwords []byte
+
 // NewStopwordManager creates a new instance of StopwordManager with the provided WordProcessor.
-// It initializes the stopwords map by loading stopwords from a JSON file. If the file cannot be loaded,
-// the function logs a fatal error and terminates the program.
+// It initializes the stopwords map from the embedded stopwords.json file; use Reload to load another file.
 func NewStopwordManager(processor WordProcessor) StopwordManager {
-	stopWordManager := stopwordManager{processor: processor, stopwords: make(map[string]map[string]string)}
+	stopWordManager := &stopwordManager{processor: processor}
 
-	err := stopWordManager.loadStopwords("./arabic/stop_words/stopwords.json")
+	set, err := parseStopwords(defaultStopwords)
 	if err != nil {
-		log.Fatal(err)
+		// The embedded file is part of the build, so it can only be invalid if the source tree is broken
+		panic("stop_words: invalid embedded stopwords.json: " + err.Error())
 	}
+	stopWordManager.set.Store(set)
 
-	return &stopWordManager
+	return stopWordManager
 }
 
 // IsStopword checks if the given word is in the stopwords list.
 // It returns true if the word is a stopword, false otherwise.
 func (sm *stopwordManager) IsStopword(word string) bool {
-	_, exists := sm.stopwords[word]
+	_, exists := sm.set.Load().stopwords[word]
 	return exists
 }
 
@@ -43,7 +72,7 @@ func (sm *stopwordManager) IsStopword(word string) bool {
 // The stem is stripped of Tashkeel characters before being returned.
 func (sm *stopwordManager) StopStem(word string) string {
 	stem := ""
-	if stopWord, exists := sm.stopwords[word]; exists {
+	if stopWord, exists := sm.set.Load().stopwords[word]; exists {
 		stem = stopWord["stem"]
 		stem = sm.processor.StripTashkeel(stem)
 	}
@@ -56,12 +85,96 @@ func (sm *stopwordManager) StopRoot(word string) string {
 	return sm.StopStem(word)
 }
 
+// Reload loads the stopwords from a JSON file specified by the filename, replacing the current stopwords.
+// The new stopwords are swapped in atomically once fully loaded, so concurrent lookups are never affected by a partial load.
+// It returns an error if the file cannot be read or the JSON cannot be unmarshaled, in which case the current stopwords are kept.
+func (sm *stopwordManager) Reload(filename string) error {
+	set, err := loadStopwords(filename)
+	if err != nil {
+		return err
+	}
+	sm.set.Store(set)
+	return nil
+}
+
+// Merge returns a new manager holding the stopwords of this one and those of the JSON document, in the format
+// of stopwords.json, along with the words whose entries differ in both, sorted. The entries of the document
+// replace those of the same words if replace is true, and are ignored otherwise. The manager itself is unchanged.
+func (sm *stopwordManager) Merge(data []byte, replace bool) (StopwordManager, []string, error) {
+	added, err := parseStopwords(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	current := sm.set.Load()
+	set := &stopwordSet{stopwords: make(map[string]map[string]string, len(current.stopwords)+len(added.stopwords))}
+	for word, entry := range current.stopwords {
+		set.stopwords[word] = entry
+	}
+	var conflicts []string
+	for word, entry := range added.stopwords {
+		if existing, exists := set.stopwords[word]; exists {
+			if !maps.Equal(existing, entry) {
+				conflicts = append(conflicts, word)
+			}
+			if !replace {
+				continue
+			}
+		}
+		set.stopwords[word] = entry
+	}
+	sort.Strings(conflicts)
+	set.indexVariants()
+
+	merged := &stopwordManager{processor: sm.processor}
+	merged.set.Store(set)
+	return merged, conflicts, nil
+}
+
+// Footprint returns the approximate memory held by the stopwords and their variant index, in bytes.
+func (sm *stopwordManager) Footprint() int {
+	set := sm.set.Load()
+	size := 2 * utils.MapHeaderSize
+	for word, entry := range set.stopwords {
+		size += utils.StringSize(word) + utils.PointerSize + utils.MapEntryOverhead + utils.MapHeaderSize
+		for key, value := range entry {
+			size += utils.StringSize(key) + utils.StringSize(value) + utils.MapEntryOverhead
+		}
+	}
+	for lemma, words := range set.variantIndex {
+		size += utils.StringSize(lemma) + utils.StringsSize(words) + utils.MapEntryOverhead
+	}
+	return size
+}
+
 // loadStopwords loads the stopwords from a JSON file specified by the filename.
 // It returns an error if the file cannot be read or the JSON cannot be unmarshaled.
-func (sm *stopwordManager) loadStopwords(filename string) error {
+func loadStopwords(filename string) (*stopwordSet, error) {
 	data, err := os.ReadFile(filename)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return json.Unmarshal(data, &sm.stopwords)
+	return parseStopwords(data)
+}
+
+// parseStopwords parses stopwords in the JSON format of stopwords.json, preceded by an optional versioned header.
+func parseStopwords(data []byte) (*stopwordSet, error) {
+	data, err := dictfile.Decode(data, dictfile.KindStopwords)
+	if err != nil {
+		return nil, err
+	}
+	set := &stopwordSet{}
+	if err := json.Unmarshal(data, &set.stopwords); err != nil {
+		return nil, err
+	}
+	set.indexVariants()
+	return set, nil
+}
+
+// Export writes the stopwords as a JSON object in the format of stopwords.json, keyed by unvocalized word,
+// so that the output can be loaded back with Reload.
+func (sm *stopwordManager) Export(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sm.set.Load().stopwords)
 }
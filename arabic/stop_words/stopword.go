@@ -0,0 +1,67 @@
+package stop_words
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"sort"
+	"strings"
+)
+
+// Stopword holds the annotations of a stopword entry.
+type Stopword struct {
+	// Word is the unvocalized surface form, including its clitics.
+	Word string
+	// Vocalized is the fully vocalized surface form.
+	Vocalized string
+	// Stem is the stopword without its clitics.
+	Stem string
+	// Lemma is the base form the entry derives from.
+	Lemma string
+	// Proclitic and Enclitic are the attached clitics, if any (e.g. "-وَ").
+	Proclitic string
+	Enclitic  string
+	// POS is the part of speech, the first of the tags (e.g. "أداة").
+	POS string
+	// Tags lists every grammatical tag of the entry (e.g. أداة، استثناء، معطوف).
+	Tags []string
+	// Type is the kind of entry, such as STOPWORD.
+	Type string
+	// Variants lists the unvocalized surface forms of every entry sharing the same lemma, including this one.
+	Variants []string
+}
+
+// Lookup returns the annotations of the given stopword, and false if the word isn't a stopword.
+func (sm *stopwordManager) Lookup(word string) (Stopword, bool) {
+	set := sm.set.Load()
+	entry, exists := set.stopwords[word]
+	if !exists {
+		return Stopword{}, false
+	}
+
+	// Some entries of stopwords.json give the word vocalized, e.g. بَيْدَ
+	stopword := Stopword{
+		Word:      normalize.StripTashkeel(entry["word"]),
+		Vocalized: entry["vocalized"],
+		Stem:      entry["stem"],
+		Lemma:     entry["original"],
+		Proclitic: entry["procletic"],
+		Enclitic:  entry["encletic"],
+		Type:      entry["type"],
+		Variants:  append([]string{}, set.variantIndex[entry["original"]]...),
+	}
+	if entry["tags"] != "" {
+		stopword.Tags = strings.Split(entry["tags"], ":")
+		stopword.POS = stopword.Tags[0]
+	}
+	return stopword, true
+}
+
+// indexVariants groups the surface forms of the stopwords by lemma.
+func (set *stopwordSet) indexVariants() {
+	set.variantIndex = make(map[string][]string)
+	for word, entry := range set.stopwords {
+		set.variantIndex[entry["original"]] = append(set.variantIndex[entry["original"]], word)
+	}
+	for _, words := range set.variantIndex {
+		sort.Strings(words)
+	}
+}
@@ -1,6 +1,8 @@
 package stop_words
 
-import "github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+)
 
 type TashkeelChecker interface {
 	IsTashkeel(char rune) bool
@@ -16,5 +18,5 @@ func NewTashkeelChecker() TashkeelChecker {
 
 // IsTashkeel returns true if the given character is a Tashkeel, false otherwise.
 func (t *tashkeelChecker) IsTashkeel(char rune) bool {
-	return constant.TASHKEEL[char]
+	return normalize.IsTashkeel(char)
 }
@@ -1,7 +1,7 @@
 package stop_words
 
 import (
-	"strings"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
 	"unicode"
 )
 
@@ -49,17 +49,5 @@ func (wp *wordProcessor) IsAlpha(word string) bool {
 // StripTashkeel removes all Tashkeel characters from the given text.
 // It returns the text without Tashkeel characters, preserving the original order of the remaining characters.
 func (wp *wordProcessor) StripTashkeel(text string) string {
-	if text == "" {
-		return text
-	}
-	if wp.IsVocalized(text) {
-		var result strings.Builder
-		for _, char := range text {
-			if !wp.tashkeelChecker.IsTashkeel(char) {
-				result.WriteRune(char)
-			}
-		}
-		return result.String()
-	}
-	return text
+	return normalize.StripTashkeel(text)
 }
@@ -0,0 +1,66 @@
+package tokenizer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"strings"
+)
+
+// compoundJoiners are the characters joining the words of a compound written without spaces,
+// e.g. the slash of عربي/إنجليزي. The kashida is handled separately, as it usually elongates a letter.
+const compoundJoiners = "-‐‑/،"
+
+// nonConnectingLetters never connect to the letter following them, so a kashida after them can't elongate them.
+const nonConnectingLetters = "اأإآٱدذرزوؤةىء"
+
+// Compound is a token made of words joined without spaces, e.g. عربي/إنجليزي. Plain words have a single part.
+type Compound struct {
+	// Text is the token as written.
+	Text string
+	// Parts are the words of the token, in order.
+	Parts []string
+	// Joiners holds the characters joining each part to the next one, so it has one element less than Parts.
+	Joiners []string
+}
+
+// splitCompound splits a token on its joiners. A kashida is a joiner when it follows a non-connecting letter,
+// e.g. مصرـالسودان, and is otherwise kept within the part it elongates. Leading and trailing joiners are dropped.
+func splitCompound(token string) Compound {
+	var compound Compound
+	partStart, joinerStart := -1, -1
+	var previous rune
+	for i, char := range token {
+		isJoiner := strings.ContainsRune(compoundJoiners, char)
+		if string(char) == constant.TATWEEL {
+			isJoiner = partStart < 0 || strings.ContainsRune(nonConnectingLetters, previous)
+		} else if !isJoiner {
+			previous = char
+		}
+		switch {
+		case isJoiner && partStart >= 0:
+			compound.Parts = append(compound.Parts, token[partStart:i])
+			partStart, joinerStart = -1, i
+		case !isJoiner && partStart < 0:
+			if joinerStart >= 0 && len(compound.Parts) > 0 {
+				compound.Joiners = append(compound.Joiners, token[joinerStart:i])
+			}
+			partStart = i
+		}
+	}
+	if partStart >= 0 {
+		compound.Parts = append(compound.Parts, token[partStart:])
+	}
+	compound.Text = strings.Join(interleave(compound.Parts, compound.Joiners), "")
+	return compound
+}
+
+// interleave returns the parts with the joiners between them.
+func interleave(parts, joiners []string) []string {
+	all := make([]string, 0, len(parts)+len(joiners))
+	for i, part := range parts {
+		if i > 0 {
+			all = append(all, joiners[i-1])
+		}
+		all = append(all, part)
+	}
+	return all
+}
@@ -0,0 +1,61 @@
+package tokenizer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"regexp"
+	"strings"
+)
+
+type Tokenizer interface {
+	Tokenize(text string) []string
+}
+
+// CompoundTokenizer is implemented by tokenizers keeping the words of compounds together, such as those created
+// by NewTokenizer. It is kept apart from Tokenizer so that other implementations needn't provide it.
+type CompoundTokenizer interface {
+	TokenizeCompounds(text string) []Compound
+}
+
+// TypedTokenizer is implemented by tokenizers labeling tokens with their type, such as those created by NewTokenizer.
+// It is kept apart from Tokenizer so that other implementations needn't provide it.
+type TypedTokenizer interface {
+	TokenizeTyped(text string) []Token
+}
+
+// tokenizer splits text into word tokens.
+type tokenizer struct {
+	separator *regexp.Regexp
+}
+
+// NewTokenizer creates a new instance of Tokenizer.
+// Tokens are runs of letters, combining marks (such as tashkeel), digits and apostrophes; anything else separates them.
+// Compound tokens may also hold the hyphens, slashes, Arabic commas and kashidas joining their words.
+func NewTokenizer() Tokenizer {
+	// QuoteMeta doesn't escape the hyphen, which would otherwise make a range within the class
+	joiners := strings.ReplaceAll(regexp.QuoteMeta(compoundJoiners), "-", `\-`)
+	return &tokenizer{separator: regexp.MustCompile(`[^\p{L}\p{M}\p{N}'` + joiners + `]+`)}
+}
+
+// Tokenize splits the given text into word tokens, in the order they appear.
+// Invisible characters such as ZWNJ or RLM are removed first, so they never split a word.
+// The words of compounds are separate tokens, see TokenizeCompounds.
+func (t *tokenizer) Tokenize(text string) []string {
+	var tokens []string
+	for _, compound := range t.TokenizeCompounds(text) {
+		tokens = append(tokens, compound.Parts...)
+	}
+	return tokens
+}
+
+// TokenizeCompounds splits the given text into tokens like Tokenize, but keeps the words joined without spaces
+// by hyphens, slashes, Arabic commas or kashidas together, e.g. عربي/إنجليزي, reporting their parts and joiners.
+// Tokens made only of joiners are dropped.
+func (t *tokenizer) TokenizeCompounds(text string) []Compound {
+	var compounds []Compound
+	for _, token := range t.separator.Split(normalize.StripInvisible(text), -1) {
+		if compound := splitCompound(token); len(compound.Parts) > 0 {
+			compounds = append(compounds, compound)
+		}
+	}
+	return compounds
+}
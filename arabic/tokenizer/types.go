@@ -0,0 +1,98 @@
+package tokenizer
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/chars"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"regexp"
+)
+
+// TokenType is the kind of a token, telling how a pipeline should process it.
+type TokenType int
+
+const (
+	// ArabicWord is a word with at least one letter of the Arabic script.
+	ArabicWord TokenType = iota
+	// LatinWord is a word of another script, mostly Latin, e.g. a brand name or an Arabizi word.
+	LatinWord
+	// Number is a run of digits, in any script, possibly with decimal and thousands separators, e.g. ٣٫٥.
+	Number
+	// Punctuation is a single punctuation mark or symbol.
+	Punctuation
+	// Emoji is a run of emoji and other pictographs, with their modifiers.
+	Emoji
+	// URL is a web address starting with a scheme or with www.
+	URL
+	// Mention is a social media user name, e.g. @user.
+	Mention
+	// Hashtag is a social media hashtag, e.g. #يوم_الجمعة.
+	Hashtag
+	// NumberWords is a number written with words, e.g. ثلاثة وعشرون, and Date a date, e.g. 15 مايو 2023.
+	// TokenizeTyped doesn't produce them: they are recognized over several tokens by the numeral package.
+	NumberWords
+	Date
+)
+
+var tokenTypeNames = []string{"arabic_word", "latin_word", "number", "punctuation", "emoji", "url", "mention", "hashtag", "number_words", "date"}
+
+// String returns the name of the token type, e.g. arabic_word.
+func (t TokenType) String() string {
+	if t < ArabicWord || int(t) >= len(tokenTypeNames) {
+		return "unknown"
+	}
+	return tokenTypeNames[t]
+}
+
+// TokenTypes returns every token type, in order.
+func TokenTypes() []TokenType {
+	return []TokenType{ArabicWord, LatinWord, Number, Punctuation, Emoji, URL, Mention, Hashtag, NumberWords, Date}
+}
+
+// Token is a token of a text with its type.
+type Token struct {
+	Text string
+	Type TokenType
+	// Offset is the byte offset of the token in the text, after the removal of invisible characters.
+	Offset int
+}
+
+// typedToken matches a token of any type. The alternatives are tried in order, so URLs, mentions and hashtags
+// take precedence over the words they contain, and a word may start with digits while a number can't hold letters.
+var typedToken = regexp.MustCompile(
+	`(?P<url>(?:[hH][tT][tT][pP][sS]?://|www\.)[^\s<>"]*[^\s<>".,;:!?)\]'،؛؟])` +
+		`|(?P<mention>@[\p{L}\p{M}\p{N}_]+)` +
+		`|(?P<hashtag>#[\p{L}\p{M}\p{N}_]+)` +
+		`|(?P<word>[\p{N}']*[\p{L}\p{M}][\p{L}\p{M}\p{N}']*)` +
+		`|(?P<number>\p{N}+(?:[.,٫٬]\p{N}+)*)` +
+		`|(?P<emoji>[\x{1F000}-\x{1FAFF}\x{2600}-\x{27BF}\x{2B00}-\x{2BFF}][\x{1F000}-\x{1FAFF}\x{2600}-\x{27BF}\x{2B00}-\x{2BFF}\x{FE0F}\x{20E3}]*)` +
+		`|(?P<punctuation>[\p{P}\p{S}])`,
+)
+
+// typedGroups maps the named groups of typedToken to the token types, words being told apart by their script.
+var typedGroups = map[string]TokenType{
+	"url": URL, "mention": Mention, "hashtag": Hashtag, "word": ArabicWord,
+	"number": Number, "emoji": Emoji, "punctuation": Punctuation,
+}
+
+// TokenizeTyped splits the given text into tokens labeled with their type, in the order they appear.
+// Unlike Tokenize, it keeps punctuation, emoji, URLs, mentions and hashtags, and doesn't split compounds:
+// their joiners are punctuation tokens. Invisible characters are removed first, as for Tokenize.
+func (t *tokenizer) TokenizeTyped(text string) []Token {
+	text = normalize.StripInvisible(text)
+	names := typedToken.SubexpNames()
+	var tokens []Token
+	for _, match := range typedToken.FindAllStringSubmatchIndex(text, -1) {
+		for group := 1; group < len(names); group++ {
+			start, end := match[2*group], match[2*group+1]
+			if start < 0 {
+				continue
+			}
+			token := Token{Text: text[start:end], Type: typedGroups[names[group]], Offset: start}
+			if token.Type == ArabicWord && !chars.ContainsArabic(token.Text) {
+				token.Type = LatinWord
+			}
+			tokens = append(tokens, token)
+			break
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,24 @@
+package utils
+
+// Levenshtein returns the edit distance between two strings, counted in runes.
+// Insertions, deletions and substitutions each cost one edit.
+func Levenshtein(a, b string) int {
+	runeA, runeB := []rune(a), []rune(b)
+	previousRow := make([]int, len(runeB)+1)
+	for j := range previousRow {
+		previousRow[j] = j
+	}
+	for i := 1; i <= len(runeA); i++ {
+		currentRow := make([]int, len(runeB)+1)
+		currentRow[0] = i
+		for j := 1; j <= len(runeB); j++ {
+			cost := 1
+			if runeA[i-1] == runeB[j-1] {
+				cost = 0
+			}
+			currentRow[j] = Min(Min(currentRow[j-1]+1, previousRow[j]+1), previousRow[j-1]+cost)
+		}
+		previousRow = currentRow
+	}
+	return previousRow[len(runeB)]
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"unsafe"
+)
+
+// Approximate sizes, in bytes on 64-bit platforms, used to estimate the memory held by dictionaries.
+const (
+	StringHeaderSize = 16
+	SliceHeaderSize  = 24
+	PointerSize      = 8
+	// MapHeaderSize is the size of a map header, and MapEntryOverhead the per-entry cost of a map beyond
+	// its keys and values: bucket metadata and the slack left by the load factor.
+	MapHeaderSize    = 48
+	MapEntryOverhead = 16
+)
+
+// Footprinter is implemented by the dictionaries able to estimate the memory they hold, in bytes.
+type Footprinter interface {
+	Footprint() int
+}
+
+// StringSize returns the approximate memory held by a string, its header included.
+func StringSize(s string) int {
+	return StringHeaderSize + len(s)
+}
+
+// StringsSize returns the approximate memory held by a slice of strings, its header included.
+func StringsSize(list []string) int {
+	size := SliceHeaderSize
+	for _, s := range list {
+		size += StringSize(s)
+	}
+	return size
+}
+
+// Footprint returns the approximate memory held by the trie, in bytes.
+func (t *Trie[K]) Footprint() int {
+	var key K
+	size := int(unsafe.Sizeof(*t))
+	if t.children != nil {
+		size += MapHeaderSize
+	}
+	for _, child := range t.children {
+		size += int(unsafe.Sizeof(key)) + PointerSize + MapEntryOverhead + child.Footprint()
+	}
+	return size
+}
@@ -1,37 +1,116 @@
 package utils
 
-import (
-	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
-	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/regex"
+import "github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+
+// The normalization functions and policies have moved to the normalize package, which every subsystem shares.
+// The aliases and wrappers below keep existing code compiling.
+
+// Deprecated: Use normalize.AlefTreatment.
+type AlefTreatment = normalize.AlefTreatment
+
+// Deprecated: Use normalize.HamzaLevel.
+type HamzaLevel = normalize.HamzaLevel
+
+// Deprecated: Use normalize.TehMarbutaPolicy.
+type TehMarbutaPolicy = normalize.TehMarbutaPolicy
+
+// Deprecated: Use normalize.AlefMaksuraPolicy.
+type AlefMaksuraPolicy = normalize.AlefMaksuraPolicy
+
+// Deprecated: Use the constants of the normalize package.
+const (
+	AlefToPlain      = normalize.AlefToPlain
+	AlefKeep         = normalize.AlefKeep
+	AlefStrip        = normalize.AlefStrip
+	HamzaNone        = normalize.HamzaNone
+	HamzaSeats       = normalize.HamzaSeats
+	HamzaFull        = normalize.HamzaFull
+	TehMarbutaStrip  = normalize.TehMarbutaStrip
+	TehMarbutaToHeh  = normalize.TehMarbutaToHeh
+	TehMarbutaKeep   = normalize.TehMarbutaKeep
+	AlefMaksuraToYeh = normalize.AlefMaksuraToYeh
+	AlefMaksuraKeep  = normalize.AlefMaksuraKeep
+	YehToAlefMaksura = normalize.YehToAlefMaksura
 )
 
+// Deprecated: Use normalize.StripTashkeel.
 func StripTashkeel(text string) string {
-	return regex.CreateHarakatPattern().ReplaceAllString(text, "")
+	return normalize.StripTashkeel(text)
 }
 
+// Deprecated: Use normalize.IsTashkeel.
+func IsTashkeel(char rune) bool {
+	return normalize.IsTashkeel(char)
+}
+
+// Deprecated: Use normalize.StripInvisible.
+func StripInvisible(text string) string {
+	return normalize.StripInvisible(text)
+}
+
+// Deprecated: Use normalize.StripTatweel.
 func StripTatweel(text string) string {
-	return regex.CreateTatwaalPattern().ReplaceAllString(text, "")
+	return normalize.StripTatweel(text)
 }
 
+// Deprecated: Use normalize.Hamza with normalize.HamzaSeats.
 func NormalizeHamza(text string) string {
-	text = regex.CreateAlefatPattern().ReplaceAllString(text, constant.ALEF)
-	return regex.CreateHamzatPattern().ReplaceAllString(text, "\u0621")
+	return normalize.Hamza(text, normalize.HamzaSeats)
+}
+
+// Deprecated: Use normalize.Hamza.
+func NormalizeHamzaLevel(text string, level HamzaLevel) string {
+	return normalize.Hamza(text, level)
+}
+
+// Deprecated: Use normalize.CollapseHamza.
+func CollapseHamza(text string) string {
+	return normalize.CollapseHamza(text)
 }
 
+// Deprecated: Use normalize.LamAlef.
 func NormalizeLamAlef(text string) string {
-	return regex.CreateLamAlefatPattern().ReplaceAllString(text, constant.LAM_ALEF+constant.ALEF)
+	return normalize.LamAlef(text)
+}
+
+// Deprecated: Use normalize.AlefWasla.
+func NormalizeAlefWasla(text string, treatment AlefTreatment) string {
+	return normalize.AlefWasla(text, treatment)
+}
+
+// Deprecated: Use normalize.DaggerAlef.
+func NormalizeDaggerAlef(text string, treatment AlefTreatment) string {
+	return normalize.DaggerAlef(text, treatment)
+}
+
+// Deprecated: Use normalize.TehMarbuta.
+func NormalizeTehMarbuta(text string, policy TehMarbutaPolicy) string {
+	return normalize.TehMarbuta(text, policy)
 }
 
+// Deprecated: Use normalize.AlefMaksura.
+func NormalizeAlefMaksura(text string, policy AlefMaksuraPolicy) string {
+	return normalize.AlefMaksura(text, policy)
+}
+
+// Deprecated: Use normalize.SpellErrors.
 func NormalizeSpellErrors(text string) string {
-	text = regex.CreateTehMarbutaPattern().ReplaceAllString(text, constant.HEH)
-	return regex.CreateAlefMaksuraPattern().ReplaceAllString(text, constant.YEH)
+	return normalize.SpellErrors(text)
+}
+
+// Deprecated: Use normalize.Text with the policies set in normalize.SearchOptions.
+func NormalizeSpellErrorsWith(text string, tehMarbuta TehMarbutaPolicy, alefMaksura AlefMaksuraPolicy) string {
+	return normalize.AlefMaksura(normalize.TehMarbuta(text, tehMarbuta), alefMaksura)
 }
 
+// Deprecated: Use normalize.SearchText.
 func NormalizeSearchText(text string) string {
-	text = StripTashkeel(text)
-	text = StripTatweel(text)
-	text = NormalizeLamAlef(text)
-	text = NormalizeHamza(text)
-	text = NormalizeSpellErrors(text)
-	return text
+	return normalize.SearchText(text)
+}
+
+// Deprecated: Use normalize.Text with the policies set in normalize.SearchOptions.
+func NormalizeSearchTextWith(text string, tehMarbuta TehMarbutaPolicy, alefMaksura AlefMaksuraPolicy) string {
+	options := normalize.SearchOptions()
+	options.TehMarbuta, options.AlefMaksura = tehMarbuta, alefMaksura
+	return normalize.Text(text, options)
 }
@@ -0,0 +1,53 @@
+package utils
+
+// Set is an unordered collection of distinct values.
+// The zero value is not usable: create sets with NewSet.
+type Set[T comparable] map[T]struct{}
+
+// NewSet creates a set holding the given values, duplicates being kept once.
+func NewSet[T comparable](values ...T) Set[T] {
+	set := make(Set[T], len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
+
+// Add inserts the value into the set.
+func (s Set[T]) Add(value T) {
+	s[value] = struct{}{}
+}
+
+// Remove deletes the value from the set, if present.
+func (s Set[T]) Remove(value T) {
+	delete(s, value)
+}
+
+// Has reports whether the value is in the set. It is safe to call on a nil set.
+func (s Set[T]) Has(value T) bool {
+	_, ok := s[value]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Values returns the values of the set, in no particular order.
+func (s Set[T]) Values() []T {
+	values := make([]T, 0, len(s))
+	for value := range s {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Clone returns a copy of the set that can be modified independently.
+func (s Set[T]) Clone() Set[T] {
+	clone := make(Set[T], len(s))
+	for value := range s {
+		clone[value] = struct{}{}
+	}
+	return clone
+}
@@ -0,0 +1,58 @@
+package utils
+
+// Trie is a prefix tree over sequences of keys, such as the runes of the affixes known to the stemmer.
+// Each node records whether a sequence ends there; the zero value is an empty trie ready to use.
+type Trie[K comparable] struct {
+	children map[K]*Trie[K]
+	terminal bool
+}
+
+// NewTrie creates a trie holding the given sequences.
+func NewTrie[K comparable](sequences ...[]K) *Trie[K] {
+	trie := &Trie[K]{}
+	for _, sequence := range sequences {
+		trie.Insert(sequence)
+	}
+	return trie
+}
+
+// Insert adds the sequence to the trie. Inserting an empty sequence marks the root as terminal.
+func (t *Trie[K]) Insert(sequence []K) {
+	node := t
+	for _, key := range sequence {
+		child, ok := node.children[key]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[K]*Trie[K])
+			}
+			child = &Trie[K]{}
+			node.children[key] = child
+		}
+		node = child
+	}
+	node.terminal = true
+}
+
+// Child returns the node reached from this one by the given key, and false if there is none.
+func (t *Trie[K]) Child(key K) (*Trie[K], bool) {
+	child, ok := t.children[key]
+	return child, ok
+}
+
+// Terminal reports whether an inserted sequence ends at this node.
+func (t *Trie[K]) Terminal() bool {
+	return t.terminal
+}
+
+// Contains reports whether the exact sequence was inserted into the trie.
+func (t *Trie[K]) Contains(sequence []K) bool {
+	node := t
+	for _, key := range sequence {
+		child, ok := node.Child(key)
+		if !ok {
+			return false
+		}
+		node = child
+	}
+	return node.terminal
+}
@@ -0,0 +1,147 @@
+package vectorize
+
+import (
+	"errors"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"math"
+	"sort"
+)
+
+var (
+	// ErrNotFitted is returned when documents are transformed before the vectorizer has been fitted.
+	ErrNotFitted = errors.New("vectorize: vectorizer is not fitted")
+	// ErrEmptyVocabulary is returned when no term is left after applying the document frequency filters.
+	ErrEmptyVocabulary = errors.New("vectorize: empty vocabulary after document frequency filtering")
+)
+
+// Options configures the terms kept by a Vectorizer.
+type Options struct {
+	// RemoveStopwords leaves stopwords out of the vocabulary.
+	RemoveStopwords bool
+	// MinDF is the minimum number of documents a term must appear in. Zero or less keeps every term.
+	MinDF int
+	// MaxDF is the maximum proportion of documents a term may appear in, between 0 and 1.
+	// Zero or less keeps every term.
+	MaxDF float64
+}
+
+type Vectorizer interface {
+	Fit(documents []string) error
+	Transform(documents []string) ([][]float64, error)
+	FitTransform(documents []string) ([][]float64, error)
+	Vocabulary() []string
+}
+
+// tfidfVectorizer builds TF-IDF document-term matrices whose terms are normalized light stems.
+type tfidfVectorizer struct {
+	stemmer    *stemmer.ArabicLightStemmer
+	options    Options
+	vocabulary []string
+	index      map[string]int
+	idf        []float64
+}
+
+// NewTfidfVectorizer creates a new instance of Vectorizer using the given stemmer to turn tokens into terms.
+// Terms are weighted by their raw count times a smoothed inverse document frequency, and rows are L2-normalized.
+func NewTfidfVectorizer(als *stemmer.ArabicLightStemmer, options Options) Vectorizer {
+	return &tfidfVectorizer{stemmer: als, options: options}
+}
+
+// Fit learns the vocabulary and inverse document frequencies from the given documents.
+// Terms are sorted alphabetically, so the column order doesn't depend on the order of the documents.
+func (tv *tfidfVectorizer) Fit(documents []string) error {
+	documentFrequency := make(map[string]int)
+	for _, document := range documents {
+		for term := range tv.countTerms(document) {
+			documentFrequency[term]++
+		}
+	}
+
+	var vocabulary []string
+	for term, df := range documentFrequency {
+		if tv.options.MinDF > 0 && df < tv.options.MinDF {
+			continue
+		}
+		if tv.options.MaxDF > 0 && float64(df) > tv.options.MaxDF*float64(len(documents)) {
+			continue
+		}
+		vocabulary = append(vocabulary, term)
+	}
+	if len(vocabulary) == 0 {
+		return ErrEmptyVocabulary
+	}
+	sort.Strings(vocabulary)
+
+	tv.vocabulary = vocabulary
+	tv.index = make(map[string]int, len(vocabulary))
+	tv.idf = make([]float64, len(vocabulary))
+	n := float64(len(documents))
+	for i, term := range vocabulary {
+		tv.index[term] = i
+		tv.idf[i] = math.Log((1+n)/(1+float64(documentFrequency[term]))) + 1
+	}
+	return nil
+}
+
+// Transform converts the given documents into rows of TF-IDF weights over the fitted vocabulary.
+// Terms missing from the vocabulary are ignored; a document without any known term yields a zero row.
+func (tv *tfidfVectorizer) Transform(documents []string) ([][]float64, error) {
+	if tv.index == nil {
+		return nil, ErrNotFitted
+	}
+	matrix := make([][]float64, len(documents))
+	for i, document := range documents {
+		row := make([]float64, len(tv.vocabulary))
+		for term, count := range tv.countTerms(document) {
+			if column, exists := tv.index[term]; exists {
+				row[column] = float64(count) * tv.idf[column]
+			}
+		}
+		normalize(row)
+		matrix[i] = row
+	}
+	return matrix, nil
+}
+
+// FitTransform fits the vectorizer on the given documents and returns their TF-IDF matrix.
+func (tv *tfidfVectorizer) FitTransform(documents []string) ([][]float64, error) {
+	if err := tv.Fit(documents); err != nil {
+		return nil, err
+	}
+	return tv.Transform(documents)
+}
+
+// Vocabulary returns the fitted terms, in column order.
+func (tv *tfidfVectorizer) Vocabulary() []string {
+	return append([]string{}, tv.vocabulary...)
+}
+
+// countTerms tokenizes a document and counts its normalized light stems, leaving out stopwords if required.
+func (tv *tfidfVectorizer) countTerms(document string) map[string]int {
+	counts := make(map[string]int)
+	tokens := tv.stemmer.Tokenize(document)
+	if tv.options.RemoveStopwords {
+		tokens = tv.stemmer.RemoveStopwords(tokens)
+	}
+	for _, token := range tokens {
+		if term := tv.stemmer.NormalizeSearchText(tv.stemmer.LightStem(token)); term != "" {
+			counts[term]++
+		}
+	}
+	return counts
+}
+
+// normalize scales the row to unit Euclidean length, leaving zero rows unchanged.
+func normalize(row []float64) {
+	var sum float64
+	for _, value := range row {
+		sum += value * value
+	}
+	if sum == 0 {
+		return
+	}
+	norm := math.Sqrt(sum)
+	for i := range row {
+		row[i] /= norm
+	}
+}
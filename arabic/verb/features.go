@@ -0,0 +1,146 @@
+package verb
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/plural"
+	"strings"
+)
+
+// Person describes the grammatical person of a verb.
+type Person int
+
+// Tense describes the aspect of a verb form.
+type Tense string
+
+const (
+	FirstPerson  Person = 1
+	SecondPerson Person = 2
+	ThirdPerson  Person = 3
+
+	TensePerfect   Tense = "perfect"
+	TenseImperfect Tense = "imperfect"
+)
+
+// Features holds one morphosyntactic reading of a conjugated verb.
+type Features struct {
+	Prefix string
+	Stem   string
+	Suffix string
+	// Subject is the part of the suffix marking the subject, e.g. ون in يكتبونه.
+	Subject string
+	// Object is the attached object pronoun, e.g. ه in يكتبونه.
+	Object string
+	Person Person
+	Gender plural.Gender
+	Number plural.Number
+	Tense  Tense
+	// Voice is only set for vocalized words, see DetectVoice.
+	Voice Voice
+}
+
+// reading is a single person/gender/number combination.
+type reading struct {
+	person Person
+	gender plural.Gender
+	number plural.Number
+}
+
+var (
+	m1s = reading{FirstPerson, plural.GenderUnknown, plural.NumberSingular}
+	m1p = reading{FirstPerson, plural.GenderUnknown, plural.NumberPlural}
+	m2s = reading{SecondPerson, plural.GenderMasculine, plural.NumberSingular}
+	f2s = reading{SecondPerson, plural.GenderFeminine, plural.NumberSingular}
+	x2d = reading{SecondPerson, plural.GenderUnknown, plural.NumberDual}
+	m2p = reading{SecondPerson, plural.GenderMasculine, plural.NumberPlural}
+	f2p = reading{SecondPerson, plural.GenderFeminine, plural.NumberPlural}
+	m3s = reading{ThirdPerson, plural.GenderMasculine, plural.NumberSingular}
+	f3s = reading{ThirdPerson, plural.GenderFeminine, plural.NumberSingular}
+	m3d = reading{ThirdPerson, plural.GenderMasculine, plural.NumberDual}
+	f3d = reading{ThirdPerson, plural.GenderFeminine, plural.NumberDual}
+	m3p = reading{ThirdPerson, plural.GenderMasculine, plural.NumberPlural}
+	f3p = reading{ThirdPerson, plural.GenderFeminine, plural.NumberPlural}
+)
+
+// perfectReadings maps the subject suffixes of the perfect to their readings.
+var perfectReadings = map[string][]reading{
+	"":    {m3s},
+	"ت":   {m1s, m2s, f2s, f3s},
+	"تما": {x2d},
+	"تم":  {m2p},
+	"تن":  {f2p},
+	"نا":  {m1p},
+	"ا":   {m3d},
+	"تا":  {f3d},
+	"وا":  {m3p},
+	"ن":   {f3p},
+}
+
+// imperfectReadings maps the conjugation prefix letter and subject suffix of the imperfect to their readings.
+var imperfectReadings = map[string]map[string][]reading{
+	constant.ALEF_HAMZA_ABOVE: {"": {m1s}},
+	constant.NOON:             {"": {m1p}},
+	constant.YEH: {
+		"":   {m3s},
+		"ان": {m3d},
+		"ون": {m3p},
+		"وا": {m3p},
+		"ن":  {f3p},
+	},
+	constant.TEH: {
+		"":   {m2s, f3s},
+		"ين": {f2s},
+		"ي":  {f2s},
+		"ان": {x2d, f3d},
+		"ا":  {x2d, f3d},
+		"ون": {m2p},
+		"وا": {m2p},
+		"ن":  {f2p},
+	},
+}
+
+// objectPronouns lists the object pronouns that may follow the subject suffix.
+var objectPronouns = []string{"", "ه", "ها", "هما", "هم", "هن", "ك", "كما", "كم", "كن", "ني", "نا"}
+
+// Analyze maps a verb prefix and suffix combination to every morphosyntactic reading it admits.
+// The conjugation letter is taken from the end of the prefix, after proclitics such as و, ف, س and ل.
+// A prefix without a conjugation letter is analyzed as a perfect form.
+func Analyze(prefix, stem, suffix string) []Features {
+	var features []Features
+	conjugation := ""
+	for letter := range imperfectReadings {
+		if strings.HasSuffix(prefix, letter) {
+			conjugation = letter
+		}
+	}
+
+	for _, object := range objectPronouns {
+		if !strings.HasSuffix(suffix, object) {
+			continue
+		}
+		subject := strings.TrimSuffix(suffix, object)
+
+		var readings []reading
+		tense := TensePerfect
+		if conjugation != "" {
+			readings = imperfectReadings[conjugation][subject]
+			tense = TenseImperfect
+		} else {
+			readings = perfectReadings[subject]
+		}
+
+		for _, r := range readings {
+			features = append(features, Features{
+				Prefix:  prefix,
+				Stem:    stem,
+				Suffix:  suffix,
+				Subject: subject,
+				Object:  object,
+				Person:  r.person,
+				Gender:  r.gender,
+				Number:  r.number,
+				Tense:   tense,
+			})
+		}
+	}
+	return features
+}
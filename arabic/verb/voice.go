@@ -0,0 +1,83 @@
+package verb
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"strings"
+)
+
+// Voice describes whether the subject of a verb form is its agent or its patient.
+type Voice string
+
+const (
+	// VoiceUnknown is the voice of unvocalized forms, and of forms whose vowels don't tell the voice.
+	VoiceUnknown Voice = ""
+	VoiceActive  Voice = "active"
+	VoicePassive Voice = "passive"
+)
+
+// DetectVoice guesses the voice of a reading from the vowels of the vocalized word it was found in:
+//   - a perfect form is passive when its first vowel is a damma and the letter before its last radical
+//     doesn't carry a fatha or damma, e.g. كُتِبَ or اُسْتُخْدِمَ, and active when its first vowel is a fatha, e.g. كَتَبَ;
+//   - an imperfect form is active when its conjugation letter carries a fatha, e.g. يَكْتُبُ; with a damma,
+//     it is passive when the letter before its last radical carries a fatha, e.g. يُكْتَبُ, and active with a kasra,
+//     e.g. يُخْرِجُ.
+//
+// It returns VoiceUnknown when the vowels needed are missing, or if the letters of the word don't match the reading.
+func DetectVoice(vocalized string, features Features) Voice {
+	marks := letterMarks(vocalized)
+	start := len([]rune(features.Prefix))
+	stem := len([]rune(features.Stem))
+	if stem < 2 || len(marks) != start+stem+len([]rune(features.Suffix)) {
+		return VoiceUnknown
+	}
+	penultimate := marks[start+stem-2]
+
+	if features.Tense == TenseImperfect {
+		if start == 0 {
+			return VoiceUnknown
+		}
+		switch conjugation := marks[start-1]; {
+		case strings.Contains(conjugation, constant.FATHA):
+			return VoiceActive
+		case !strings.Contains(conjugation, constant.DAMMA):
+			return VoiceUnknown
+		case strings.Contains(penultimate, constant.FATHA):
+			return VoicePassive
+		case strings.Contains(penultimate, constant.KASRA):
+			return VoiceActive
+		}
+		return VoiceUnknown
+	}
+
+	for _, mark := range marks[start : start+stem-1] {
+		switch {
+		case strings.Contains(mark, constant.FATHA):
+			return VoiceActive
+		case strings.Contains(mark, constant.DAMMA):
+			if strings.Contains(penultimate, constant.FATHA) || strings.Contains(penultimate, constant.DAMMA) {
+				// كُتُب is a plural noun rather than a verb
+				return VoiceUnknown
+			}
+			return VoicePassive
+		case strings.Contains(mark, constant.KASRA):
+			return VoiceUnknown
+		}
+	}
+	return VoiceUnknown
+}
+
+// letterMarks returns the tashkeel marks following each letter of the word.
+func letterMarks(word string) []string {
+	var marks []string
+	for _, char := range word {
+		if normalize.IsTashkeel(char) {
+			if len(marks) > 0 {
+				marks[len(marks)-1] += string(char)
+			}
+			continue
+		}
+		marks = append(marks, "")
+	}
+	return marks
+}
@@ -0,0 +1,178 @@
+package weak
+
+import (
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/constant"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/roots"
+	"sync"
+)
+
+// Policy controls which classes of weak roots are reconstructed and how candidates are chosen.
+type Policy struct {
+	// Hollow enables reconstruction of a weak middle radical, e.g. قال → قول.
+	Hollow bool
+	// Defective enables reconstruction of a weak final radical, e.g. دعا → دعو.
+	Defective bool
+	// Assimilated enables reconstruction of a dropped initial WAW or YEH, e.g. يصل → وصل.
+	Assimilated bool
+	// UseDictionary selects the first candidate found in the roots dictionary instead of the first candidate.
+	UseDictionary bool
+	// DefaultWeakLetter is the weak letter preferred when no candidate can be confirmed, WAW by default.
+	DefaultWeakLetter string
+}
+
+// DefaultPolicy returns the policy used by the stemmer by default: every class is reconstructed
+// and candidates are confirmed against the roots dictionary.
+func DefaultPolicy() Policy {
+	return Policy{
+		Hollow:            true,
+		Defective:         true,
+		Assimilated:       true,
+		UseDictionary:     true,
+		DefaultWeakLetter: constant.WAW,
+	}
+}
+
+// yehWeakRoots returns the set of the roots of constant.YEH_WEAK_ROOTS, built on first use.
+var yehWeakRoots = sync.OnceValue(func() map[string]bool {
+	weakRoots := make(map[string]bool, len(constant.YEH_WEAK_ROOTS))
+	for _, root := range constant.YEH_WEAK_ROOTS {
+		weakRoots[root] = true
+	}
+	return weakRoots
+})
+
+type WeakRootResolver interface {
+	Reconstruct(radicals, starStem string) string
+	Candidates(radicals, starStem string) []string
+	Policy() Policy
+}
+
+// weakRootResolver reconstructs hollow, defective and assimilated roots.
+type weakRootResolver struct {
+	policy       Policy
+	rootsManager roots.RootsManager
+	joker        rune
+}
+
+// NewWeakRootResolver creates a new instance of WeakRootResolver with the provided policy and RootsManager.
+// The joker is the character marking root letters in star stems.
+func NewWeakRootResolver(policy Policy, rootsManager roots.RootsManager, joker string) WeakRootResolver {
+	if policy.DefaultWeakLetter != constant.YEH {
+		policy.DefaultWeakLetter = constant.WAW
+	}
+	if joker == "" {
+		joker = constant.DEFAULT_JOKER
+	}
+	return &weakRootResolver{policy: policy, rootsManager: rootsManager, joker: []rune(joker)[0]}
+}
+
+// Policy returns the policy used by the resolver.
+func (wr *weakRootResolver) Policy() Policy {
+	return wr.policy
+}
+
+// Reconstruct returns the most likely full root for the given radicals.
+// When the policy uses the dictionary, the first candidate that is a known root is returned;
+// otherwise, or if none is known, the first candidate is returned.
+func (wr *weakRootResolver) Reconstruct(radicals, starStem string) string {
+	candidates := wr.Candidates(radicals, starStem)
+	if len(candidates) == 0 {
+		return radicals
+	}
+	if wr.policy.UseDictionary {
+		for _, candidate := range candidates {
+			if wr.rootsManager.IsRoot(candidate) {
+				return candidate
+			}
+		}
+	}
+	return candidates[0]
+}
+
+// Candidates returns the possible full roots for the given radicals, most likely first.
+// Three radicals are checked for a weak letter written as ALEF or ALEF MAKSURA. Two radicals are completed
+// using the star stem, in which root letters are marked with the joker and infix letters are kept:
+// a leading weak letter suggests an assimilated root, a trailing one a defective root and a middle one a hollow root.
+// If the star stem gives no hint, every enabled class is tried: assimilated roots first for bare two-letter
+// stems, hollow roots first otherwise.
+func (wr *weakRootResolver) Candidates(radicals, starStem string) []string {
+	runeRadicals := []rune(radicals)
+	switch len(runeRadicals) {
+	case 3:
+		return wr.triliteralCandidates(runeRadicals)
+	case 2:
+		return wr.biliteralCandidates(runeRadicals, []rune(starStem))
+	}
+	return []string{radicals}
+}
+
+// triliteralCandidates replaces a weak letter written as ALEF or ALEF MAKSURA with its underlying WAW or YEH.
+func (wr *weakRootResolver) triliteralCandidates(r []rune) []string {
+	alef := []rune(constant.ALEF)[0]
+	alefMaksura := []rune(constant.ALEF_MAKSURA)[0]
+	switch {
+	case wr.policy.Hollow && r[1] == alef:
+		return wr.withWeakLetter(func(weak string) string { return string(r[0]) + weak + string(r[2]) })
+	case wr.policy.Defective && r[2] == alefMaksura:
+		return []string{string(r[:2]) + constant.YEH, string(r[:2]) + constant.WAW}
+	case wr.policy.Defective && r[2] == alef:
+		return wr.withWeakLetter(func(weak string) string { return string(r[:2]) + weak })
+	}
+	return []string{string(r)}
+}
+
+// biliteralCandidates completes a two-letter root with the weak letter suggested by the star stem.
+func (wr *weakRootResolver) biliteralCandidates(r []rune, starStem []rune) []string {
+	first, second := string(r[0]), string(r[1])
+	assimilated := func(weak string) string { return weak + first + second }
+	hollow := func(weak string) string { return first + weak + second }
+	defective := func(weak string) string { return first + second + weak }
+
+	if len(starStem) > 0 {
+		head := string(starStem[0])
+		tail := string(starStem[len(starStem)-1])
+		switch {
+		case wr.policy.Assimilated && (head == constant.ALEF || head == constant.WAW):
+			return []string{assimilated(constant.WAW)}
+		case wr.policy.Assimilated && head == constant.YEH:
+			return []string{assimilated(constant.YEH)}
+		case wr.policy.Defective && starStem[0] == wr.joker && isWeak(tail):
+			return wr.withWeakLetter(defective)
+		case wr.policy.Hollow && starStem[0] == wr.joker && len(starStem) == 3 && isWeak(string(starStem[1])):
+			return wr.withWeakLetter(hollow)
+		}
+	}
+
+	var candidates []string
+	if wr.policy.Assimilated && len(starStem) == 2 {
+		// A bare two-letter stem most often comes from an imperfect that dropped its initial WAW
+		candidates = append(candidates, assimilated(constant.WAW))
+	}
+	if wr.policy.Hollow {
+		candidates = append(candidates, wr.withWeakLetter(hollow)...)
+	}
+	if wr.policy.Assimilated && len(starStem) != 2 {
+		candidates = append(candidates, assimilated(constant.WAW))
+	}
+	if wr.policy.Defective {
+		candidates = append(candidates, wr.withWeakLetter(defective)...)
+	}
+	if len(candidates) == 0 {
+		candidates = append(candidates, first+second)
+	}
+	return candidates
+}
+
+// withWeakLetter builds a candidate with each weak letter, the policy's default letter first unless the YEH reading
+// is one of constant.YEH_WEAK_ROOTS.
+func (wr *weakRootResolver) withWeakLetter(build func(weak string) string) []string {
+	if wr.policy.DefaultWeakLetter == constant.YEH || yehWeakRoots()[build(constant.YEH)] {
+		return []string{build(constant.YEH), build(constant.WAW)}
+	}
+	return []string{build(constant.WAW), build(constant.YEH)}
+}
+
+// isWeak checks if the given letter is one of the weak letters ALEF, WAW, YEH or ALEF MAKSURA.
+func isWeak(letter string) bool {
+	return letter == constant.ALEF || letter == constant.WAW || letter == constant.YEH || letter == constant.ALEF_MAKSURA
+}
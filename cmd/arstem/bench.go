@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+)
+
+// runBench implements `arstem bench [--input corpus.txt] [--config file] [--root] [--cpuprofile file] [--memprofile file]`.
+// The corpus is tokenized up front, then every token is stemmed while measuring time and allocations,
+// so that the figures reflect the stemmer alone. The corpus is read from standard input if no input is given.
+func runBench(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	inputPath := flags.String("input", "", "corpus file (default standard input)")
+	config := flags.String("config", "", "YAML configuration of the stemmer")
+	roots := flags.Bool("root", false, "extract roots too")
+	cpuProfile := flags.String("cpuprofile", "", "write a CPU profile of the stemming to this file")
+	memProfile := flags.String("memprofile", "", "write a heap profile to this file after stemming")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	als := stemmer.NewArabicLightStemmer()
+	if *config != "" {
+		var err error
+		if als, err = loadStemmer(*config); err != nil {
+			return err
+		}
+	}
+
+	var paths []string
+	if *inputPath != "" {
+		paths = []string{*inputPath}
+	}
+	input, err := openCorpus(paths)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	var tokens []string
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		tokens = append(tokens, als.Tokenize(scanner.Text())...)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("the corpus holds no words")
+	}
+	distinct := make(map[string]bool)
+	for _, token := range tokens {
+		distinct[token] = true
+	}
+
+	if *cpuProfile != "" {
+		file, err := os.Create(*cpuProfile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		if err := pprof.StartCPUProfile(file); err != nil {
+			return err
+		}
+	}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for _, token := range tokens {
+		als.LightStem(token)
+		if *roots {
+			als.Root(token)
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	if *cpuProfile != "" {
+		pprof.StopCPUProfile()
+	}
+	if *memProfile != "" {
+		file, err := os.Create(*memProfile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		if err := pprof.WriteHeapProfile(file); err != nil {
+			return err
+		}
+	}
+
+	words := float64(len(tokens))
+	fmt.Printf("words\t%d\n", len(tokens))
+	fmt.Printf("distinct words\t%d\n", len(distinct))
+	fmt.Printf("elapsed\t%s\n", elapsed.Round(time.Microsecond))
+	fmt.Printf("words/sec\t%.0f\n", words/elapsed.Seconds())
+	fmt.Printf("ns/word\t%.0f\n", float64(elapsed.Nanoseconds())/words)
+	fmt.Printf("allocs/word\t%.1f\n", float64(after.Mallocs-before.Mallocs)/words)
+	fmt.Printf("bytes/word\t%.0f\n", float64(after.TotalAlloc-before.TotalAlloc)/words)
+	return nil
+}
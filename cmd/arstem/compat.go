@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/eval"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"os"
+)
+
+// runCompat implements `arstem compat fixtures.tsv`.
+// It compares the stemmer with Tashaphyne outputs and prints the agreement rates followed by every divergence.
+func runCompat(args []string) error {
+	flags := flag.NewFlagSet("compat", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("compat expects a single fixtures file")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	fixtures, err := eval.LoadTashaphyneFixtures(file)
+	if err != nil {
+		return err
+	}
+
+	report := eval.CompareTashaphyne(stemmer.NewArabicLightStemmer(), fixtures)
+	fmt.Printf("words: %d\nstem agreement: %.2f%%\nroot agreement: %.2f%%\n", report.Total, report.StemAgreement*100, report.RootAgreement*100)
+	for _, d := range report.Divergences {
+		fmt.Printf("%s\tstem %s -> %s\troot %s -> %s\n", d.Word, d.TashaphyneStem, d.Stem, d.TashaphyneRoot, d.Root)
+	}
+	return nil
+}
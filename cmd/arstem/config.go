@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"gopkg.in/yaml.v3"
+	"os"
+)
+
+// loadStemmer creates a stemmer configured from the YAML file at the given path.
+// The keys are those of stemmer.Config, and options left out keep their default value.
+func loadStemmer(path string) (*stemmer.ArabicLightStemmer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := stemmer.DefaultConfig()
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	als, err := stemmer.NewFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return als, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"sort"
+)
+
+// wordDiff is a word stemmed differently under two configurations.
+type wordDiff struct {
+	word         string
+	count        int
+	stemA, stemB string
+}
+
+// runDiff implements `arstem diff --config-a a.yaml --config-b b.yaml [-root] [corpus...]`.
+// It prints the words of the corpus that stem differently under the two configurations, most frequent first,
+// followed by a summary. The corpus files are read in order, or standard input if none is given.
+func runDiff(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	configA := flags.String("config-a", "", "YAML configuration of the first stemmer")
+	configB := flags.String("config-b", "", "YAML configuration of the second stemmer")
+	compareRoots := flags.Bool("root", false, "compare roots instead of light stems")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *configA == "" || *configB == "" {
+		return fmt.Errorf("diff requires both --config-a and --config-b")
+	}
+
+	stemmerA, err := loadStemmer(*configA)
+	if err != nil {
+		return err
+	}
+	stemmerB, err := loadStemmer(*configB)
+	if err != nil {
+		return err
+	}
+	stem := func(als *stemmer.ArabicLightStemmer, word string) string {
+		if *compareRoots {
+			return als.Root(word)
+		}
+		return als.LightStem(word)
+	}
+
+	input, err := openCorpus(flags.Args())
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	tokens := 0
+	counts := make(map[string]int)
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		for _, token := range stemmerA.Tokenize(scanner.Text()) {
+			tokens++
+			counts[token]++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	var diffs []wordDiff
+	differingTokens := 0
+	for word, count := range counts {
+		stemA, stemB := stem(stemmerA, word), stem(stemmerB, word)
+		if stemA != stemB {
+			diffs = append(diffs, wordDiff{word: word, count: count, stemA: stemA, stemB: stemB})
+			differingTokens += count
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].count != diffs[j].count {
+			return diffs[i].count > diffs[j].count
+		}
+		return diffs[i].word < diffs[j].word
+	})
+
+	for _, d := range diffs {
+		fmt.Printf("%d\t%s\t%s\t%s\n", d.count, d.word, d.stemA, d.stemB)
+	}
+	fmt.Printf("distinct words: %d, differing: %d\ntokens: %d, differing: %d\n", len(counts), len(diffs), tokens, differingTokens)
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/analysis"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"io"
+	"os"
+	"strings"
+)
+
+// runFreq implements `arstem freq [-top N] [-stopwords] [-format csv|json] [corpus...]`.
+// The corpus files are read in order, or standard input if none is given.
+func runFreq(args []string) error {
+	flags := flag.NewFlagSet("freq", flag.ContinueOnError)
+	top := flags.Int("top", 0, "keep only the N most frequent stems and roots (0 keeps all)")
+	excludeStopwords := flags.Bool("stopwords", false, "exclude stopwords from the tables")
+	format := flags.String("format", "csv", "output format: csv or json")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *format != "csv" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	input, err := openCorpus(flags.Args())
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	options := analysis.FrequencyOptions{TopN: *top, ExcludeStopwords: *excludeStopwords}
+	report, err := analysis.CountFrequencies(stemmer.NewArabicLightStemmer(), input, options)
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		return report.WriteJSON(os.Stdout)
+	}
+	return report.WriteCSV(os.Stdout)
+}
+
+// openCorpus returns a reader over the concatenated corpus files, or standard input if no file is given.
+func openCorpus(paths []string) (io.ReadCloser, error) {
+	if len(paths) == 0 {
+		return io.NopCloser(os.Stdin), nil
+	}
+	var readers []io.Reader
+	var files []*os.File
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			for _, f := range files {
+				f.Close()
+			}
+			return nil, err
+		}
+		files = append(files, file)
+		// Separate files with a newline, so that the last line of one file isn't joined to the first line of the next
+		readers = append(readers, file, strings.NewReader("\n"))
+	}
+	return &multiFile{Reader: io.MultiReader(readers...), files: files}, nil
+}
+
+// multiFile reads several files in sequence and closes them all at once.
+type multiFile struct {
+	io.Reader
+	files []*os.File
+}
+
+func (mf *multiFile) Close() error {
+	var firstErr error
+	for _, file := range mf.files {
+		if err := file.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/ngram"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/normalize"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"os"
+)
+
+// runLM implements `arstem lm [-order N] [-stems] [-o file] [corpus...]`.
+// It trains a character language model on the tokens of the corpus files, or of standard input,
+// and writes it as JSON for the -lm flag of the stem command, or stemmer.SetLanguageModel.
+func runLM(args []string) error {
+	flags := flag.NewFlagSet("lm", flag.ContinueOnError)
+	order := flags.Int("order", ngram.DefaultOrder, "length of the longest character n-grams")
+	stems := flags.Bool("stems", false, "train on the light stems of the tokens rather than the tokens themselves")
+	output := flags.String("o", "", "output file (standard output if empty)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	input, err := openCorpus(flags.Args())
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	als := stemmer.NewArabicLightStemmer()
+	model := ngram.NewModel(*order)
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		for _, token := range als.Tokenize(scanner.Text()) {
+			if *stems {
+				token = als.LightStem(token)
+			}
+			if token = normalize.StripTashkeel(token); token != "" {
+				model.Train(token)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return model.WriteJSON(os.Stdout)
+	}
+	file, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := model.WriteJSON(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// loadLanguageModel reads a language model written by the lm command.
+func loadLanguageModel(path string) (ngram.Model, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ngram.LoadJSON(file)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// command is an arstem subcommand. It receives the arguments following its name.
+type command struct {
+	name        string
+	description string
+	run         func(args []string) error
+}
+
+var commands = []command{
+	{name: "stem", description: "print the stem, root and affixes of every word of a corpus", run: runStem},
+	{name: "freq", description: "emit stem and root frequency tables for a corpus", run: runFreq},
+	{name: "compat", description: "compare outputs with Tashaphyne fixtures", run: runCompat},
+	{name: "diff", description: "show words stemmed differently under two configurations", run: runDiff},
+	{name: "bench", description: "measure stemming throughput and allocations on a corpus", run: runBench},
+	{name: "lm", description: "train a character language model breaking ties between candidate stems", run: runLM},
+	{name: "stopwords", description: "propose the most widespread stems of a corpus as stopwords", run: runStopwords},
+	{name: "pgdict", description: "export a PostgreSQL text search dictionary for a vocabulary", run: runPgDict},
+	{name: "seal", description: "add a versioned header with a checksum to a dictionary file", run: runSeal},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			if err := cmd.run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "arstem:", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "arstem: unknown command %q\n", os.Args[1])
+	usage()
+	os.Exit(2)
+}
+
+// usage prints the list of available subcommands.
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: arstem <command> [flags] [args]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-9s %s\n", cmd.name, cmd.description)
+	}
+}
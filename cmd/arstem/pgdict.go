@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/export"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"os"
+)
+
+// runPgDict implements `arstem pgdict [-format synonym|thesaurus] [vocabulary...]`.
+// It writes a PostgreSQL text search dictionary file for the words of the vocabulary files, or of standard input.
+func runPgDict(args []string) error {
+	flags := flag.NewFlagSet("pgdict", flag.ContinueOnError)
+	format := flags.String("format", "synonym", "dictionary template: synonym or thesaurus")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	var pgFormat export.PostgresFormat
+	switch *format {
+	case "synonym":
+		pgFormat = export.PostgresSynonym
+	case "thesaurus":
+		pgFormat = export.PostgresThesaurus
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	input, err := openCorpus(flags.Args())
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	var vocabulary []string
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		vocabulary = append(vocabulary, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return export.WritePostgresDictionary(os.Stdout, stemmer.NewArabicLightStemmer(), vocabulary, pgFormat)
+}
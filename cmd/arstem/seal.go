@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/dictfile"
+	"io"
+	"os"
+)
+
+// runSeal implements `arstem seal -kind roots|prefixes|suffixes|verbs|stopwords|protected|expressions [file]`.
+// It writes the dictionary file, or standard input, preceded by a versioned header with its checksum,
+// so that the stemmer rejects it at load time if it is later corrupted or loaded as another dictionary.
+func runSeal(args []string) error {
+	flags := flag.NewFlagSet("seal", flag.ContinueOnError)
+	kind := flags.String("kind", "", "dictionary kind: roots, prefixes, suffixes, verbs, stopwords, protected or expressions")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	switch dictfile.Kind(*kind) {
+	case dictfile.KindRoots, dictfile.KindPrefixes, dictfile.KindSuffixes, dictfile.KindVerbs,
+		dictfile.KindStopwords, dictfile.KindProtectedWords, dictfile.KindExpressions:
+	default:
+		return fmt.Errorf("unknown dictionary kind %q", *kind)
+	}
+
+	input, err := openCorpus(flags.Args())
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+	content, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+	// Sealing a sealed file again replaces its header, after checking it
+	if content, err = dictfile.Decode(content, dictfile.Kind(*kind)); err != nil {
+		return err
+	}
+	return dictfile.Encode(os.Stdout, dictfile.Kind(*kind), content)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/export"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// engines maps the names accepted by -engine to the stemming algorithms.
+var engines = map[string]stemmer.Engine{
+	"light":      stemmer.EngineLight,
+	"arlstem":    stemmer.EngineARLSTem,
+	"snowball":   stemmer.EngineSnowball,
+	"light10":    stemmer.EngineLight10,
+	"light10ext": stemmer.EngineLight10Extended,
+}
+
+// stemColumns maps the column names accepted by -columns to their value for a segmentation.
+var stemColumns = map[string]func(word string, s stemmer.Segmentation) string{
+	"word":     func(word string, s stemmer.Segmentation) string { return word },
+	"stem":     func(word string, s stemmer.Segmentation) string { return s.Stem },
+	"root":     func(word string, s stemmer.Segmentation) string { return s.Root },
+	"prefix":   func(word string, s stemmer.Segmentation) string { return s.Prefix },
+	"suffix":   func(word string, s stemmer.Segmentation) string { return s.Suffix },
+	"starword": func(word string, s stemmer.Segmentation) string { return s.Prefix + s.StarStem + s.Suffix },
+	"confidence": func(word string, s stemmer.Segmentation) string {
+		return strconv.FormatFloat(s.Confidence, 'f', 2, 64)
+	},
+}
+
+// rowWriter writes one record per token in an output format.
+type rowWriter interface {
+	Write(record []string) error
+	Flush() error
+}
+
+// runStem implements `arstem stem [-format tsv|csv|jsonl|gob] [-columns word,stem,...] [-header] [-config file] [-lm file] [-engine name] [corpus...]`.
+// It prints one record per token of the corpus. The corpus files are read in order, or standard input if none is given.
+// The gob format writes batches of export.TokenColumns instead, with the document (line) and offset of every token.
+func runStem(args []string) error {
+	flags := flag.NewFlagSet("stem", flag.ContinueOnError)
+	format := flags.String("format", "tsv", "output format: tsv, csv, jsonl or gob (columnar, ignores -columns)")
+	columns := flags.String("columns", "word,stem,root", "comma-separated columns among word, stem, root, prefix, suffix, starword and confidence")
+	header := flags.Bool("header", false, "print the column names first (tsv and csv only)")
+	config := flags.String("config", "", "YAML configuration of the stemmer")
+	languageModel := flags.String("lm", "", "character language model written by the lm command")
+	engine := flags.String("engine", "", "stemming algorithm: light, arlstem, snowball, light10 or light10ext (default from the configuration)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	names := strings.Split(*columns, ",")
+	for i, name := range names {
+		names[i] = strings.TrimSpace(name)
+		if stemColumns[names[i]] == nil {
+			return fmt.Errorf("unknown column %q", names[i])
+		}
+	}
+
+	output := bufio.NewWriter(os.Stdout)
+	var writer rowWriter
+	switch *format {
+	case "tsv":
+		writer = &tsvWriter{w: output}
+	case "csv":
+		writer = &csvWriter{csv.NewWriter(output), output}
+	case "jsonl":
+		writer = &jsonlWriter{w: output, names: names}
+	case "gob":
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	als := stemmer.NewArabicLightStemmer()
+	if *config != "" {
+		var err error
+		if als, err = loadStemmer(*config); err != nil {
+			return err
+		}
+	}
+
+	if *engine != "" {
+		selected, ok := engines[*engine]
+		if !ok {
+			return fmt.Errorf("unknown engine %q", *engine)
+		}
+		als.SetEngine(selected)
+	}
+
+	if *languageModel != "" {
+		model, err := loadLanguageModel(*languageModel)
+		if err != nil {
+			return err
+		}
+		als.SetLanguageModel(model)
+	}
+
+	input, err := openCorpus(flags.Args())
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	if *format == "gob" {
+		return export.WriteCorpusColumns(als, input, export.NewGobColumnWriter(os.Stdout), 0)
+	}
+	if *header && *format != "jsonl" {
+		if err := writer.Write(names); err != nil {
+			return err
+		}
+	}
+	record := make([]string, len(names))
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		for _, token := range als.Tokenize(scanner.Text()) {
+			segmentation := als.StemSegmentation(token)
+			for i, name := range names {
+				record[i] = stemColumns[name](token, segmentation)
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// tsvWriter writes tab-separated records. Tokens never hold tabs or newlines, so no quoting is needed.
+type tsvWriter struct {
+	w *bufio.Writer
+}
+
+func (tw *tsvWriter) Write(record []string) error {
+	_, err := tw.w.WriteString(strings.Join(record, "\t") + "\n")
+	return err
+}
+
+func (tw *tsvWriter) Flush() error {
+	return tw.w.Flush()
+}
+
+// csvWriter writes RFC 4180 records.
+type csvWriter struct {
+	cw *csv.Writer
+	w  *bufio.Writer
+}
+
+func (cw *csvWriter) Write(record []string) error {
+	return cw.cw.Write(record)
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.cw.Flush()
+	if err := cw.cw.Error(); err != nil {
+		return err
+	}
+	return cw.w.Flush()
+}
+
+// jsonlWriter writes one JSON object per line, with the keys in column order.
+type jsonlWriter struct {
+	w     *bufio.Writer
+	names []string
+}
+
+func (jw *jsonlWriter) Write(record []string) error {
+	var line strings.Builder
+	line.WriteByte('{')
+	for i, name := range jw.names {
+		if i > 0 {
+			line.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		value, _ := json.Marshal(record[i])
+		line.Write(key)
+		line.WriteByte(':')
+		line.Write(value)
+	}
+	line.WriteString("}\n")
+	_, err := jw.w.WriteString(line.String())
+	return err
+}
+
+func (jw *jsonlWriter) Flush() error {
+	return jw.w.Flush()
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/analysis"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"os"
+)
+
+// runStopwords implements `arstem stopwords [-top N] [-new] [-freq] [corpus...]`.
+// It proposes the stems found in the most documents of the corpus, one document per line, as stopwords,
+// and writes them in the format of stopwords.json. With -freq, the input is a word frequency list instead.
+func runStopwords(args []string) error {
+	flags := flag.NewFlagSet("stopwords", flag.ContinueOnError)
+	top := flags.Int("top", 200, "keep the N stems found in the most documents (0 keeps all)")
+	onlyNew := flags.Bool("new", false, "leave out the stems of known stopwords")
+	frequencyList := flags.Bool("freq", false, "read word frequency lists (word, count, documents) instead of a corpus")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	input, err := openCorpus(flags.Args())
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	als := stemmer.NewArabicLightStemmer()
+	var words []analysis.WordFrequency
+	if *frequencyList {
+		words, err = analysis.ReadWordFrequencies(input)
+	} else {
+		words, err = analysis.CountWordFrequencies(als, input)
+	}
+	if err != nil {
+		return err
+	}
+	candidates := analysis.StopwordCandidates(als, words, analysis.StopwordOptions{TopN: *top, ExcludeKnown: *onlyNew})
+	return analysis.WriteStopwords(os.Stdout, candidates)
+}
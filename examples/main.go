@@ -2,11 +2,14 @@ package main
 
 import (
 	"fmt"
-	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic/stemmer"
+	"github.com/berkayersoyy/go-arabic-light-stemmer/arabic"
 )
 
 func main() {
-	arStemmer := stemmer.NewArabicLightStemmer()
-	stem := arStemmer.LightStem("أفتضاربانني")
+	analyzer := arabic.NewAnalyzer()
+	stem := analyzer.Stem("أفتضاربانني")
 	fmt.Println("Stemmed word:", stem)
+	for _, analysis := range analyzer.Analyze("النص العربي هنا") {
+		fmt.Println(analysis.Word, analysis.Stem, analysis.Root, analysis.Stopword)
+	}
 }
@@ -1,3 +1,8 @@
 module github.com/berkayersoyy/go-arabic-light-stemmer
 
 go 1.21.5
+
+require (
+	golang.org/x/sync v0.9.0
+	gopkg.in/yaml.v3 v3.0.1
+)